@@ -0,0 +1,54 @@
+package buffergenerics
+
+import (
+	"encoding/binary"
+	"io"
+)
+
+// ReadAlignedOrderedT reads a naturally aligned value of type T from the given buffer, using the specified
+// byte order. If the byte order is nil, it defaults to binary.NativeEndian. The offset is first rounded up to
+// a multiple of SizeOf[T](), matching C struct layout rules for naturally aligned members.
+// It returns the read value, the offset immediately following it, and any error encountered during the read
+// operation, including io.EOF for a negative offset. On error, the returned offset is the original offset.
+// See also: ReadOrderedT.
+func ReadAlignedOrderedT[T Numeric](buffer []byte, offset int, order binary.ByteOrder) (value T, nextOffset int, err error) {
+	if offset < 0 {
+		return *new(T), offset, io.EOF
+	}
+
+	size := SizeOf[T]()
+	aligned := AlignOffset(offset, size)
+
+	value, err = ReadOrderedT[T](buffer, aligned, order)
+	if err != nil {
+		return value, offset, err
+	}
+
+	return value, aligned + size, nil
+}
+
+// AlignOffset returns offset rounded up to the next multiple of align, or offset itself if it is already aligned.
+// An align of 1 or less leaves offset unchanged. Negative offsets round up towards zero, e.g. -3 aligns to 0 for
+// an align of 4.
+func AlignOffset(offset, align int) int {
+	return offset + PaddingFor(offset, align)
+}
+
+// PaddingFor returns the number of padding bytes needed to advance offset to the next multiple of align.
+// An align of 1 or less requires no padding. The result is always in [0, align), including for negative offsets.
+func PaddingFor(offset, align int) int {
+	if align <= 1 {
+		return 0
+	}
+
+	rem := offset % align
+	if rem < 0 {
+		rem += align
+	}
+
+	if rem != 0 {
+		return align - rem
+	}
+
+	return 0
+}
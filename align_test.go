@@ -0,0 +1,136 @@
+package buffergenerics
+
+import (
+	"encoding/binary"
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/stretchr/testify/assert"
+	"io"
+	"math"
+	"testing"
+)
+
+func TestReadAlignedOrderedT(t *testing.T) {
+	t.Run("it should read at the padded position for misaligned offsets", func(t *testing.T) {
+		order := binary.LittleEndian
+		want := gofakeit.Uint32()
+		buf := make([]byte, 12)
+		order.PutUint32(buf[8:], want)
+
+		got, next, err := ReadAlignedOrderedT[uint32](buf, 5, order)
+
+		assert.NoError(t, err, "it should not return an error")
+		assert.Equal(t, want, got)
+		assert.Equal(t, 12, next)
+	})
+
+	t.Run("it should not pad already aligned offsets", func(t *testing.T) {
+		order := binary.BigEndian
+		want := gofakeit.Int64()
+		buf := make([]byte, 16)
+		order.PutUint64(buf[8:], uint64(want))
+
+		got, next, err := ReadAlignedOrderedT[int64](buf, 8, order)
+
+		assert.NoError(t, err, "it should not return an error")
+		assert.Equal(t, want, got)
+		assert.Equal(t, 16, next)
+	})
+
+	t.Run("it should chain reads following C struct layout", func(t *testing.T) {
+		// struct { uint8 a; uint16 b; uint32 c; } => a@0, b@2, c@4
+		order := binary.LittleEndian
+		buf := []byte{0x01, 0x00, 0x02, 0x00, 0x03, 0x00, 0x00, 0x00}
+
+		a, off, _ := ReadAlignedOrderedT[uint8](buf, 0, order)
+		b, off, _ := ReadAlignedOrderedT[uint16](buf, off, order)
+		c, off, err := ReadAlignedOrderedT[uint32](buf, off, order)
+
+		assert.NoError(t, err, "it should not return an error")
+		assert.Equal(t, uint8(1), a)
+		assert.Equal(t, uint16(2), b)
+		assert.Equal(t, uint32(3), c)
+		assert.Equal(t, 8, off)
+	})
+
+	t.Run("it should return an EOF error and the original offset when the padded read overruns", func(t *testing.T) {
+		buf := make([]byte, 10)
+
+		_, next, err := ReadAlignedOrderedT[uint64](buf, 1, binary.LittleEndian)
+
+		assert.ErrorIs(t, err, io.EOF)
+		assert.Equal(t, 1, next)
+	})
+
+	t.Run("it should return an EOF error and the original offset for negative offsets", func(t *testing.T) {
+		buf := []byte{0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08}
+
+		got, next, err := ReadAlignedOrderedT[uint32](buf, -3, binary.BigEndian)
+
+		assert.ErrorIs(t, err, io.EOF)
+		assert.Zero(t, got)
+		assert.Equal(t, -3, next)
+	})
+}
+
+func TestAlignOffset(t *testing.T) {
+	t.Run("it should leave already aligned offsets unchanged", func(t *testing.T) {
+		assert.Equal(t, 0, AlignOffset(0, 4))
+		assert.Equal(t, 8, AlignOffset(8, 4))
+		assert.Equal(t, 16, AlignOffset(16, 8))
+	})
+
+	t.Run("it should round misaligned offsets up", func(t *testing.T) {
+		assert.Equal(t, 4, AlignOffset(1, 4))
+		assert.Equal(t, 8, AlignOffset(5, 8))
+		assert.Equal(t, 6, AlignOffset(5, 2))
+		assert.Equal(t, 6, AlignOffset(4, 3), "it should support non-power-of-two alignments")
+	})
+
+	t.Run("it should round negative offsets up towards zero", func(t *testing.T) {
+		assert.Equal(t, 0, AlignOffset(-3, 4))
+		assert.Equal(t, -8, AlignOffset(-8, 4))
+		assert.Equal(t, -4, AlignOffset(-7, 4))
+	})
+
+	t.Run("it should leave offsets unchanged for align<=0", func(t *testing.T) {
+		assert.Equal(t, 5, AlignOffset(5, 0))
+		assert.Equal(t, -5, AlignOffset(-5, -2))
+	})
+
+	t.Run("it should leave offsets unchanged for align==1", func(t *testing.T) {
+		offset := gofakeit.IntRange(0, 1000)
+
+		assert.Equal(t, offset, AlignOffset(offset, 1))
+	})
+}
+
+func TestPaddingFor(t *testing.T) {
+	t.Run("it should require no padding for already aligned offsets", func(t *testing.T) {
+		assert.Equal(t, 0, PaddingFor(0, 8))
+		assert.Equal(t, 0, PaddingFor(12, 4))
+	})
+
+	t.Run("it should return the padding for misaligned offsets", func(t *testing.T) {
+		assert.Equal(t, 3, PaddingFor(1, 4))
+		assert.Equal(t, 1, PaddingFor(7, 8))
+		assert.Equal(t, 1, PaddingFor(5, 2))
+	})
+
+	t.Run("it should require no padding for align==1", func(t *testing.T) {
+		assert.Equal(t, 0, PaddingFor(gofakeit.IntRange(0, 1000), 1))
+	})
+
+	t.Run("it should require no padding for align<=0", func(t *testing.T) {
+		offset := gofakeit.IntRange(-1000, 1000)
+
+		assert.Equal(t, 0, PaddingFor(offset, 0))
+		assert.Equal(t, 0, PaddingFor(offset, -4))
+	})
+
+	t.Run("it should stay within [0, align) for negative offsets", func(t *testing.T) {
+		assert.Equal(t, 3, PaddingFor(-3, 4))
+		assert.Equal(t, 0, PaddingFor(-8, 4))
+		assert.Equal(t, 1, PaddingFor(-1, 2))
+		assert.Equal(t, 2, PaddingFor(math.MinInt, 3), "it should not overflow")
+	})
+}
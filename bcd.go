@@ -0,0 +1,81 @@
+package buffergenerics
+
+import (
+	"io"
+	"slices"
+)
+
+// maxBCDDigits is the largest number of decimal digits that always fits in a uint64.
+const maxBCDDigits = 19
+
+// ReadBCD reads a packed binary-coded decimal of the given number of digits from the given buffer starting at the
+// specified offset. Each byte holds two digits, most significant first, so (digits+1)/2 bytes are read; for an odd
+// number of digits, the high nibble of the first byte is padding and must be zero.
+// It returns the decoded value, io.EOF if the buffer is too short, ErrInvalidDigitCount if digits is negative or
+// greater than 19, and ErrInvalidBCD if a nibble is not a decimal digit.
+func ReadBCD(buffer []byte, offset, digits int) (uint64, error) {
+	if digits < 0 || digits > maxBCDDigits {
+		return 0, NewErrInvalidDigitCount(digits, maxBCDDigits)
+	}
+
+	n := (digits + 1) / 2
+	if offset < 0 || offset > len(buffer)-n {
+		return 0, io.EOF
+	}
+
+	var value uint64
+	for i := offset; i < offset+n; i++ {
+		hi, lo := buffer[i]>>4, buffer[i]&0x0f
+
+		if i == offset && digits%2 == 1 {
+			if hi != 0 {
+				return 0, NewErrInvalidBCD(i, buffer[i])
+			}
+		} else if hi > 9 {
+			return 0, NewErrInvalidBCD(i, buffer[i])
+		}
+
+		if lo > 9 {
+			return 0, NewErrInvalidBCD(i, buffer[i])
+		}
+
+		value = value*100 + uint64(hi)*10 + uint64(lo)
+	}
+
+	return value, nil
+}
+
+// AppendBCD appends the packed binary-coded decimal encoding of value, zero-padded to the given number of digits,
+// to the given buffer. It appends (digits+1)/2 bytes, most significant digit first, with a zero padding nibble
+// for an odd number of digits, such that ReadBCD with the same digits returns value.
+// It returns the extended buffer, or the original buffer along with ErrInvalidDigitCount if digits is negative or
+// greater than 19, and ErrBCDOverflow if value has more than digits digits.
+// See also: ReadBCD.
+func AppendBCD(buffer []byte, value uint64, digits int) ([]byte, error) {
+	if digits < 0 || digits > maxBCDDigits {
+		return buffer, NewErrInvalidDigitCount(digits, maxBCDDigits)
+	}
+
+	limit := uint64(1)
+	for range digits {
+		limit *= 10
+	}
+
+	if value >= limit {
+		return buffer, NewErrBCDOverflow(value, digits)
+	}
+
+	n := (digits + 1) / 2
+	offset := len(buffer)
+	buffer = slices.Grow(buffer, n)[:offset+n]
+
+	for i := offset + n - 1; i >= offset; i-- {
+		lo := value % 10
+		value /= 10
+		hi := value % 10
+		value /= 10
+		buffer[i] = byte(hi<<4 | lo)
+	}
+
+	return buffer, nil
+}
@@ -0,0 +1,122 @@
+package buffergenerics
+
+import (
+	"github.com/stretchr/testify/assert"
+	"io"
+	"testing"
+)
+
+func TestReadBCD(t *testing.T) {
+	t.Run("it should decode an even number of digits", func(t *testing.T) {
+		got, err := ReadBCD([]byte{0xFF, 0x12, 0x34, 0x56}, 1, 6)
+
+		assert.NoError(t, err, "it should not return an error")
+		assert.Equal(t, uint64(123456), got)
+	})
+
+	t.Run("it should decode an odd number of digits", func(t *testing.T) {
+		got, err := ReadBCD([]byte{0x01, 0x23, 0x45}, 0, 5)
+
+		assert.NoError(t, err, "it should not return an error")
+		assert.Equal(t, uint64(12345), got)
+	})
+
+	t.Run("it should decode a value with a leading zero", func(t *testing.T) {
+		got, err := ReadBCD([]byte{0x09, 0x87}, 0, 4)
+
+		assert.NoError(t, err, "it should not return an error")
+		assert.Equal(t, uint64(987), got)
+	})
+
+	t.Run("it should decode the largest supported digit count", func(t *testing.T) {
+		buf := []byte{0x09, 0x99, 0x99, 0x99, 0x99, 0x99, 0x99, 0x99, 0x99, 0x99}
+
+		got, err := ReadBCD(buf, 0, 19)
+
+		assert.NoError(t, err, "it should not return an error")
+		assert.Equal(t, uint64(9_999_999_999_999_999_999), got)
+	})
+
+	t.Run("it should return ErrInvalidBCD for a nibble greater than 9", func(t *testing.T) {
+		_, err := ReadBCD([]byte{0x12, 0x3A}, 0, 4)
+
+		var errBCD ErrInvalidBCD
+		assert.ErrorAs(t, err, &errBCD)
+		assert.Equal(t, 1, errBCD.Offset)
+		assert.Equal(t, byte(0x3A), errBCD.Byte)
+	})
+
+	t.Run("it should return ErrInvalidBCD for non-zero padding", func(t *testing.T) {
+		_, err := ReadBCD([]byte{0x11, 0x23}, 0, 3)
+
+		var errBCD ErrInvalidBCD
+		assert.ErrorAs(t, err, &errBCD)
+		assert.Equal(t, 0, errBCD.Offset)
+	})
+
+	t.Run("it should return ErrInvalidDigitCount for unsupported digit counts", func(t *testing.T) {
+		for _, digits := range []int{-1, 20} {
+			_, err := ReadBCD(make([]byte, 16), 0, digits)
+
+			var errDigits ErrInvalidDigitCount
+			assert.ErrorAs(t, err, &errDigits)
+			assert.Equal(t, digits, errDigits.Digits)
+		}
+	})
+
+	t.Run("it should return an EOF error for short buffers", func(t *testing.T) {
+		_, err := ReadBCD([]byte{0x12, 0x34}, 1, 3)
+
+		assert.ErrorIs(t, err, io.EOF)
+	})
+}
+
+func TestAppendBCD(t *testing.T) {
+	t.Run("it should zero-pad to the digit count", func(t *testing.T) {
+		got, err := AppendBCD([]byte{0xFF}, 42, 6)
+
+		assert.NoError(t, err, "it should not return an error")
+		assert.Equal(t, []byte{0xFF, 0x00, 0x00, 0x42}, got)
+	})
+
+	t.Run("it should pad odd digit counts with a zero nibble", func(t *testing.T) {
+		got, err := AppendBCD(nil, 12345, 5)
+
+		assert.NoError(t, err, "it should not return an error")
+		assert.Equal(t, []byte{0x01, 0x23, 0x45}, got)
+	})
+
+	t.Run("it should round-trip with ReadBCD", func(t *testing.T) {
+		for _, tc := range []struct {
+			value  uint64
+			digits int
+		}{{0, 0}, {7, 1}, {90, 3}, {123456, 6}, {9_999_999_999_999_999_999, 19}} {
+			buf, err := AppendBCD(nil, tc.value, tc.digits)
+			assert.NoError(t, err, "it should not return an error")
+
+			got, err := ReadBCD(buf, 0, tc.digits)
+
+			assert.NoError(t, err, "it should not return an error")
+			assert.Equal(t, tc.value, got)
+		}
+	})
+
+	t.Run("it should return ErrBCDOverflow if the value needs more digits", func(t *testing.T) {
+		buf := []byte{0xAA}
+
+		got, err := AppendBCD(buf, 1000, 3)
+
+		var errOverflow ErrBCDOverflow
+		assert.ErrorAs(t, err, &errOverflow)
+		assert.Equal(t, uint64(1000), errOverflow.Value)
+		assert.Equal(t, 3, errOverflow.Digits)
+		assert.Equal(t, buf, got, "it should return the original buffer")
+	})
+
+	t.Run("it should return ErrInvalidDigitCount for unsupported digit counts", func(t *testing.T) {
+		_, err := AppendBCD(nil, 1, 20)
+
+		var errDigits ErrInvalidDigitCount
+		assert.ErrorAs(t, err, &errDigits)
+	})
+}
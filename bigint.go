@@ -0,0 +1,61 @@
+package buffergenerics
+
+import (
+	"encoding/binary"
+	"io"
+	"math/big"
+	"slices"
+)
+
+// isLittleEndian reports whether the given byte order stores the least significant byte first.
+// If the byte order is nil, it reports on binary.NativeEndian.
+func isLittleEndian(order binary.ByteOrder) bool {
+	if order == nil {
+		order = binary.NativeEndian
+	}
+
+	return order.Uint16([]byte{0x01, 0x00}) == 0x0001
+}
+
+// ReadBigIntOrdered reads an unsigned integer of byteLen bytes from the given buffer starting at the specified
+// offset, using the specified byte order. If the byte order is nil, it defaults to binary.NativeEndian.
+// It returns the value as a new *big.Int, allowing widths beyond 64 bits such as 256-bit integers.
+// It returns io.EOF if the region lies outside the buffer.
+func ReadBigIntOrdered(buffer []byte, offset, byteLen int, order binary.ByteOrder) (*big.Int, error) {
+	if offset < 0 || byteLen < 0 || byteLen > len(buffer)-offset {
+		return nil, io.EOF
+	}
+
+	region := buffer[offset : offset+byteLen]
+
+	if isLittleEndian(order) {
+		region = slices.Clone(region)
+		slices.Reverse(region)
+	}
+
+	return new(big.Int).SetBytes(region), nil
+}
+
+// WriteBigIntOrdered writes value as an unsigned integer of exactly byteLen bytes, left-padded with zeros,
+// to the given buffer starting at the specified offset, using the specified byte order. If the byte order is nil,
+// it defaults to binary.NativeEndian. It returns io.EOF if the region lies outside the buffer and ErrBigIntRange
+// if value is negative or needs more than byteLen bytes; the buffer is not modified on error.
+// See also: ReadBigIntOrdered.
+func WriteBigIntOrdered(buffer []byte, offset, byteLen int, value *big.Int, order binary.ByteOrder) error {
+	if offset < 0 || byteLen < 0 || byteLen > len(buffer)-offset {
+		return io.EOF
+	}
+
+	if value.Sign() < 0 || (value.BitLen()+7)/8 > byteLen {
+		return NewErrBigIntRange(byteLen)
+	}
+
+	region := buffer[offset : offset+byteLen]
+	value.FillBytes(region)
+
+	if isLittleEndian(order) {
+		slices.Reverse(region)
+	}
+
+	return nil
+}
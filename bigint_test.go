@@ -0,0 +1,134 @@
+package buffergenerics
+
+import (
+	"encoding/binary"
+	"github.com/stretchr/testify/assert"
+	"io"
+	"math/big"
+	"slices"
+	"testing"
+)
+
+var bigIntBigEndian = []byte{
+	0xFF, 0xFF, 0xFF, 0xFF, 0x00, 0x00, 0x00, 0x01, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+}
+
+func bigIntFixture(t *testing.T) *big.Int {
+	// The P-256 field prime.
+	val, ok := new(big.Int).SetString("ffffffff00000001000000000000000000000000ffffffffffffffffffffffff", 16)
+	assert.True(t, ok)
+
+	return val
+}
+
+func TestReadBigIntOrdered(t *testing.T) {
+	t.Run("it should read a 32-byte big-endian value", func(t *testing.T) {
+		buf := append([]byte{0xAA}, bigIntBigEndian...)
+
+		val, err := ReadBigIntOrdered(buf, 1, 32, binary.BigEndian)
+
+		assert.NoError(t, err, "it should not return an error")
+		assert.Zero(t, bigIntFixture(t).Cmp(val))
+	})
+
+	t.Run("it should read a 32-byte little-endian value", func(t *testing.T) {
+		buf := slices.Clone(bigIntBigEndian)
+		slices.Reverse(buf)
+
+		val, err := ReadBigIntOrdered(buf, 0, 32, binary.LittleEndian)
+
+		assert.NoError(t, err, "it should not return an error")
+		assert.Zero(t, bigIntFixture(t).Cmp(val))
+	})
+
+	t.Run("it should not modify the buffer for little-endian reads", func(t *testing.T) {
+		buf := []byte{0x01, 0x02, 0x03}
+
+		val, err := ReadBigIntOrdered(buf, 0, 3, binary.LittleEndian)
+
+		assert.NoError(t, err, "it should not return an error")
+		assert.Equal(t, int64(0x030201), val.Int64())
+		assert.Equal(t, []byte{0x01, 0x02, 0x03}, buf)
+	})
+
+	t.Run("it should read a zero value", func(t *testing.T) {
+		buf := make([]byte, 32)
+
+		val, err := ReadBigIntOrdered(buf, 0, 32, binary.BigEndian)
+
+		assert.NoError(t, err, "it should not return an error")
+		assert.Zero(t, val.Sign())
+	})
+
+	t.Run("it should return an EOF error for out-of-range regions", func(t *testing.T) {
+		buf := make([]byte, 31)
+
+		_, err := ReadBigIntOrdered(buf, 0, 32, binary.BigEndian)
+		assert.ErrorIs(t, err, io.EOF)
+
+		_, err = ReadBigIntOrdered(buf, -1, 4, binary.BigEndian)
+		assert.ErrorIs(t, err, io.EOF)
+	})
+}
+
+func TestWriteBigIntOrdered(t *testing.T) {
+	t.Run("it should write a 32-byte value in both orders", func(t *testing.T) {
+		want := bigIntFixture(t)
+		little := slices.Clone(bigIntBigEndian)
+		slices.Reverse(little)
+
+		bigBuf := make([]byte, 32)
+		assert.NoError(t, WriteBigIntOrdered(bigBuf, 0, 32, want, binary.BigEndian))
+		assert.Equal(t, bigIntBigEndian, bigBuf)
+
+		littleBuf := make([]byte, 32)
+		assert.NoError(t, WriteBigIntOrdered(littleBuf, 0, 32, want, binary.LittleEndian))
+		assert.Equal(t, little, littleBuf)
+	})
+
+	t.Run("it should left-pad small values with zeros", func(t *testing.T) {
+		buf := []byte{0xAA, 0xFF, 0xFF, 0xFF, 0xFF, 0xBB}
+
+		err := WriteBigIntOrdered(buf, 1, 4, big.NewInt(0x0102), binary.BigEndian)
+
+		assert.NoError(t, err, "it should not return an error")
+		assert.Equal(t, []byte{0xAA, 0x00, 0x00, 0x01, 0x02, 0xBB}, buf)
+	})
+
+	t.Run("it should round-trip with ReadBigIntOrdered", func(t *testing.T) {
+		want := bigIntFixture(t)
+
+		for _, order := range []binary.ByteOrder{binary.BigEndian, binary.LittleEndian} {
+			buf := make([]byte, 40)
+			assert.NoError(t, WriteBigIntOrdered(buf, 3, 33, want, order))
+
+			got, err := ReadBigIntOrdered(buf, 3, 33, order)
+			assert.NoError(t, err, "it should not return an error")
+			assert.Zero(t, want.Cmp(got))
+		}
+	})
+
+	t.Run("it should return ErrBigIntRange for values that do not fit", func(t *testing.T) {
+		buf := make([]byte, 31)
+
+		err := WriteBigIntOrdered(buf, 0, 31, bigIntFixture(t), binary.BigEndian)
+
+		var errRange ErrBigIntRange
+		assert.ErrorAs(t, err, &errRange)
+		assert.Equal(t, 31, errRange.ByteLen)
+		assert.Equal(t, make([]byte, 31), buf)
+	})
+
+	t.Run("it should return ErrBigIntRange for negative values", func(t *testing.T) {
+		err := WriteBigIntOrdered(make([]byte, 8), 0, 8, big.NewInt(-1), binary.BigEndian)
+
+		assert.ErrorAs(t, err, &ErrBigIntRange{})
+	})
+
+	t.Run("it should return an EOF error for out-of-range regions", func(t *testing.T) {
+		err := WriteBigIntOrdered(make([]byte, 4), 1, 4, big.NewInt(1), binary.BigEndian)
+
+		assert.ErrorIs(t, err, io.EOF)
+	})
+}
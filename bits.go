@@ -0,0 +1,111 @@
+package buffergenerics
+
+import (
+	"encoding/binary"
+	"io"
+)
+
+// BitReader reads bit-packed fields from a buffer, tracking a cursor at bit granularity.
+// Bits are consumed MSB-first within each byte, unless the reader was created with NewBitReaderLSB.
+type BitReader struct {
+	buffer []byte
+	bit    int
+	lsb    bool
+}
+
+// NewBitReader returns a MSB-first BitReader positioned at the first bit of the given buffer.
+func NewBitReader(buffer []byte) *BitReader {
+	return &BitReader{buffer: buffer}
+}
+
+// NewBitReaderLSB returns a LSB-first BitReader positioned at the first bit of the given buffer.
+// Bits are consumed from the least significant bit of each byte onward, and the first bit read
+// becomes the least significant bit of the result, as in Deflate (RFC 1951) streams.
+func NewBitReaderLSB(buffer []byte) *BitReader {
+	return &BitReader{buffer: buffer, lsb: true}
+}
+
+// BitOffset returns the position of the cursor, in bits, from the start of the buffer.
+func (r *BitReader) BitOffset() int {
+	return r.bit
+}
+
+// Remaining returns the number of unread bits in the buffer.
+func (r *BitReader) Remaining() int {
+	return len(r.buffer)*8 - r.bit
+}
+
+// ReadBits reads the next n bits, where n is between 0 and 64, and returns them right-aligned in a uint64.
+// In MSB-first mode the first bit read is the most significant of the result; in LSB-first mode it is
+// the least significant. It returns ErrInvalidBitCount if n is out of range
+// and io.EOF if fewer than n bits remain. The cursor is only advanced on success.
+func (r *BitReader) ReadBits(n int) (uint64, error) {
+	if n < 0 || n > 64 {
+		return 0, NewErrInvalidBitCount(n)
+	}
+
+	if n > r.Remaining() {
+		return 0, io.EOF
+	}
+
+	var val uint64
+	for i := 0; i < n; i++ {
+		pos := r.bit + i
+
+		if r.lsb {
+			bit := (r.buffer[pos/8] >> (pos % 8)) & 1
+			val |= uint64(bit) << i
+		} else {
+			bit := (r.buffer[pos/8] >> (7 - pos%8)) & 1
+			val = val<<1 | uint64(bit)
+		}
+	}
+
+	r.bit += n
+	return val, nil
+}
+
+// AlignToByte discards any remaining bits in the current byte, moving the cursor to the next byte boundary.
+// It does nothing if the cursor is already byte-aligned.
+func (r *BitReader) AlignToByte() {
+	r.bit = (r.bit + 7) &^ 7
+}
+
+// ReadUnsignedBits extracts an unsigned integer of bitWidth bits, starting bitOffset bits into the given buffer,
+// without sign extension. For big-endian byte orders bits are numbered MSB-first; for little-endian ones they are
+// numbered LSB-first, as with NewBitReaderLSB. If the byte order is nil, it defaults to binary.NativeEndian.
+// It returns ErrInvalidBitCount if bitWidth is not between 0 and 64 and io.EOF if the bit range exceeds the buffer.
+// See also: ReadSignedBits.
+func ReadUnsignedBits(buffer []byte, bitOffset, bitWidth int, order binary.ByteOrder) (uint64, error) {
+	if bitOffset < 0 {
+		return 0, io.EOF
+	}
+
+	r := NewBitReader(buffer)
+	if isLittleEndian(order) {
+		r = NewBitReaderLSB(buffer)
+	}
+
+	if bitOffset > r.Remaining() {
+		return 0, io.EOF
+	}
+
+	r.bit = bitOffset
+	return r.ReadBits(bitWidth)
+}
+
+// ReadSignedBits extracts a two's complement signed integer of bitWidth bits, starting bitOffset bits into the
+// given buffer, and sign-extends it to an int64. This supports odd widths such as 12-bit or 24-bit sensor fields.
+// For big-endian byte orders bits are numbered MSB-first; for little-endian ones they are numbered LSB-first,
+// as with NewBitReaderLSB. If the byte order is nil, it defaults to binary.NativeEndian. It returns
+// ErrInvalidBitCount if bitWidth is not between 0 and 64 and io.EOF if the bit range exceeds the buffer.
+// See also: ReadUnsignedBits.
+func ReadSignedBits(buffer []byte, bitOffset, bitWidth int, order binary.ByteOrder) (int64, error) {
+	val, err := ReadUnsignedBits(buffer, bitOffset, bitWidth, order)
+	if err != nil || bitWidth == 0 {
+		return 0, err
+	}
+
+	shift := 64 - bitWidth
+	return int64(val<<shift) >> shift, nil
+}
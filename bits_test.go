@@ -0,0 +1,232 @@
+package buffergenerics
+
+import (
+	"encoding/binary"
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/stretchr/testify/assert"
+	"io"
+	"testing"
+)
+
+func TestBitReader_ReadBits(t *testing.T) {
+	t.Run("it should decode a bit-packed header MSB-first", func(t *testing.T) {
+		// version=5 (3 bits), flags=0b10011 (5 bits), length=0x2A5 (10 bits)
+		buf := []byte{0b101_10011, 0b10101001, 0b01_000000}
+		r := NewBitReader(buf)
+
+		version, err := r.ReadBits(3)
+		assert.NoError(t, err, "it should not return an error")
+		assert.Equal(t, uint64(5), version)
+
+		flags, err := r.ReadBits(5)
+		assert.NoError(t, err, "it should not return an error")
+		assert.Equal(t, uint64(0b10011), flags)
+
+		length, err := r.ReadBits(10)
+		assert.NoError(t, err, "it should not return an error")
+		assert.Equal(t, uint64(0x2A5), length)
+
+		assert.Equal(t, 18, r.BitOffset())
+	})
+
+	t.Run("it should read a full 64 bits", func(t *testing.T) {
+		want := gofakeit.Uint64()
+		buf := make([]byte, 8)
+		for i := range buf {
+			buf[i] = byte(want >> (56 - 8*i))
+		}
+
+		got, err := NewBitReader(buf).ReadBits(64)
+
+		assert.NoError(t, err, "it should not return an error")
+		assert.Equal(t, want, got)
+	})
+
+	t.Run("it should return an EOF error and not advance when too few bits remain", func(t *testing.T) {
+		r := NewBitReader([]byte{0xFF})
+		_, _ = r.ReadBits(4)
+
+		_, err := r.ReadBits(5)
+
+		assert.ErrorIs(t, err, io.EOF)
+		assert.Equal(t, 4, r.BitOffset())
+	})
+
+	t.Run("it should return ErrInvalidBitCount for out-of-range counts", func(t *testing.T) {
+		r := NewBitReader(make([]byte, 16))
+
+		_, err := r.ReadBits(65)
+
+		assert.ErrorAs(t, err, &ErrInvalidBitCount{})
+		assert.Equal(t, 0, r.BitOffset())
+	})
+}
+
+func TestBitReader_AlignToByte(t *testing.T) {
+	t.Run("it should discard the remaining bits of the current byte", func(t *testing.T) {
+		r := NewBitReader([]byte{0xE0, 0xAB})
+		_, _ = r.ReadBits(3)
+
+		r.AlignToByte()
+		assert.Equal(t, 8, r.BitOffset())
+
+		val, err := r.ReadBits(8)
+		assert.NoError(t, err, "it should not return an error")
+		assert.Equal(t, uint64(0xAB), val)
+	})
+
+	t.Run("it should do nothing when already aligned", func(t *testing.T) {
+		r := NewBitReader([]byte{0xE0, 0xAB})
+		_, _ = r.ReadBits(8)
+
+		r.AlignToByte()
+
+		assert.Equal(t, 8, r.BitOffset())
+	})
+}
+
+func TestBitReader_LSB(t *testing.T) {
+	t.Run("it should decode a Deflate-style block header LSB-first", func(t *testing.T) {
+		// BFINAL=1 (1 bit), BTYPE=0b10 (2 bits), then a 5-bit HLIT=0b01011
+		buf := []byte{0b01011_10_1}
+		r := NewBitReaderLSB(buf)
+
+		final, err := r.ReadBits(1)
+		assert.NoError(t, err, "it should not return an error")
+		assert.Equal(t, uint64(1), final)
+
+		btype, err := r.ReadBits(2)
+		assert.NoError(t, err, "it should not return an error")
+		assert.Equal(t, uint64(0b10), btype)
+
+		hlit, err := r.ReadBits(5)
+		assert.NoError(t, err, "it should not return an error")
+		assert.Equal(t, uint64(0b01011), hlit)
+	})
+
+	t.Run("it should assemble values spanning bytes from the least significant end", func(t *testing.T) {
+		buf := []byte{0xCD, 0xAB}
+
+		val, err := NewBitReaderLSB(buf).ReadBits(16)
+
+		assert.NoError(t, err, "it should not return an error")
+		assert.Equal(t, uint64(0xABCD), val)
+	})
+
+	t.Run("it should differ from the MSB-first interpretation of the same bytes", func(t *testing.T) {
+		buf := []byte{0b01011_10_1}
+
+		lsb, _ := NewBitReaderLSB(buf).ReadBits(3)
+		msb, _ := NewBitReader(buf).ReadBits(3)
+
+		assert.Equal(t, uint64(0b101), lsb)
+		assert.Equal(t, uint64(0b010), msb)
+		assert.NotEqual(t, lsb, msb)
+	})
+}
+
+func TestReadSignedBits(t *testing.T) {
+	t.Run("it should sign-extend 12-bit negative values", func(t *testing.T) {
+		buf := []byte{0xFF, 0xE0}
+
+		val, err := ReadSignedBits(buf, 0, 12, binary.BigEndian)
+
+		assert.NoError(t, err, "it should not return an error")
+		assert.Equal(t, int64(-2), val)
+	})
+
+	t.Run("it should read 12-bit positive values", func(t *testing.T) {
+		buf := []byte{0x7F, 0xF0}
+
+		val, err := ReadSignedBits(buf, 0, 12, binary.BigEndian)
+
+		assert.NoError(t, err, "it should not return an error")
+		assert.Equal(t, int64(2047), val)
+	})
+
+	t.Run("it should read 24-bit values", func(t *testing.T) {
+		neg, err := ReadSignedBits([]byte{0x80, 0x00, 0x01}, 0, 24, binary.BigEndian)
+		assert.NoError(t, err, "it should not return an error")
+		assert.Equal(t, int64(-8388607), neg)
+
+		pos, err := ReadSignedBits([]byte{0x12, 0x34, 0x56}, 0, 24, binary.BigEndian)
+		assert.NoError(t, err, "it should not return an error")
+		assert.Equal(t, int64(0x123456), pos)
+	})
+
+	t.Run("it should read widths that cross a byte boundary", func(t *testing.T) {
+		buf := []byte{0x0F, 0xF0}
+
+		val, err := ReadSignedBits(buf, 4, 8, binary.BigEndian)
+
+		assert.NoError(t, err, "it should not return an error")
+		assert.Equal(t, int64(-1), val)
+	})
+
+	t.Run("it should number bits LSB-first for little-endian orders", func(t *testing.T) {
+		// -2 as a 12-bit field in the low bits of a little-endian uint16
+		buf := []byte{0xFE, 0x0F}
+
+		val, err := ReadSignedBits(buf, 0, 12, binary.LittleEndian)
+
+		assert.NoError(t, err, "it should not return an error")
+		assert.Equal(t, int64(-2), val)
+	})
+
+	t.Run("it should return an EOF error when the bit range exceeds the buffer", func(t *testing.T) {
+		_, err := ReadSignedBits([]byte{0xFF}, 4, 5, binary.BigEndian)
+		assert.ErrorIs(t, err, io.EOF)
+
+		_, err = ReadSignedBits([]byte{0xFF}, -1, 4, binary.BigEndian)
+		assert.ErrorIs(t, err, io.EOF)
+
+		_, err = ReadSignedBits([]byte{0xFF}, 100, 4, binary.BigEndian)
+		assert.ErrorIs(t, err, io.EOF)
+	})
+}
+
+func TestReadUnsignedBits(t *testing.T) {
+	// 1 | 10110 | 1111_0000_1010 | 1 0000_0000 0000_0000 0000_0000 0000_0001
+	buf := []byte{0b1_10110_11, 0b11_0000_10, 0b10_1_00000, 0x00, 0x00, 0x00, 0b001_00000}
+
+	t.Run("it should read a 1-bit field", func(t *testing.T) {
+		val, err := ReadUnsignedBits(buf, 0, 1, binary.BigEndian)
+
+		assert.NoError(t, err, "it should not return an error")
+		assert.Equal(t, uint64(1), val)
+	})
+
+	t.Run("it should read a 5-bit field", func(t *testing.T) {
+		val, err := ReadUnsignedBits(buf, 1, 5, binary.BigEndian)
+
+		assert.NoError(t, err, "it should not return an error")
+		assert.Equal(t, uint64(0b10110), val)
+	})
+
+	t.Run("it should read a 12-bit field without sign extension", func(t *testing.T) {
+		val, err := ReadUnsignedBits(buf, 6, 12, binary.BigEndian)
+
+		assert.NoError(t, err, "it should not return an error")
+		assert.Equal(t, uint64(0xF0A), val)
+	})
+
+	t.Run("it should read a 33-bit field", func(t *testing.T) {
+		val, err := ReadUnsignedBits(buf, 18, 33, binary.BigEndian)
+
+		assert.NoError(t, err, "it should not return an error")
+		assert.Equal(t, uint64(1<<32|1), val)
+	})
+
+	t.Run("it should return an EOF error when the bit range exceeds the buffer", func(t *testing.T) {
+		_, err := ReadUnsignedBits(buf, 18, 39, binary.BigEndian)
+
+		assert.ErrorIs(t, err, io.EOF)
+	})
+
+	t.Run("it should number bits LSB-first for little-endian orders", func(t *testing.T) {
+		val, err := ReadUnsignedBits([]byte{0b101_00000}, 5, 3, binary.LittleEndian)
+
+		assert.NoError(t, err, "it should not return an error")
+		assert.Equal(t, uint64(0b101), val)
+	})
+}
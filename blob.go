@@ -0,0 +1,64 @@
+package buffergenerics
+
+import (
+	"encoding/binary"
+	"math"
+)
+
+// ReadBlobOrdered reads a length-prefixed byte blob from the given buffer starting at the specified offset, using
+// the specified byte order for the prefix of lengthSize bytes (1, 2, 4, or 8). If the byte order is nil, it defaults
+// to binary.NativeEndian. It returns a copy of the blob and the total number of bytes consumed, including the
+// prefix. It returns ErrInvalidLengthSize for an unsupported lengthSize and io.EOF if the blob is truncated.
+// See also: ReadStringOrdered.
+func ReadBlobOrdered(buffer []byte, offset, lengthSize int, order binary.ByteOrder) (blob []byte, bytesRead int, err error) {
+	start, end, err := readLengthPrefix(buffer, offset, lengthSize, order)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return Clone(buffer[start:end]), end - offset, nil
+}
+
+// AppendBlobOrdered appends blob to the given buffer preceded by its length as an unsigned prefix of lengthSize bytes
+// (1, 2, 4, or 8), using the specified byte order. If the byte order is nil, it defaults to binary.NativeEndian.
+// It returns the extended buffer, or the original buffer along with ErrInvalidLengthSize for an unsupported
+// lengthSize and ErrLengthOverflow if len(blob) cannot be represented in lengthSize bytes.
+// See also: ReadBlobOrdered.
+func AppendBlobOrdered(buffer []byte, blob []byte, lengthSize int, order binary.ByteOrder) ([]byte, error) {
+	grown, err := appendLengthPrefix(buffer, len(blob), lengthSize, order)
+	if err != nil {
+		return buffer, err
+	}
+
+	return append(grown, blob...), nil
+}
+
+// appendLengthPrefix appends length as an unsigned prefix of lengthSize bytes to the given buffer, using the
+// specified byte order. It is the counterpart of readLengthPrefix, returning ErrInvalidLengthSize if lengthSize is
+// not 1, 2, 4, or 8 and ErrLengthOverflow if length does not fit.
+func appendLengthPrefix(buffer []byte, length, lengthSize int, order binary.ByteOrder) ([]byte, error) {
+	switch lengthSize {
+	case 1:
+		if length > math.MaxUint8 {
+			return buffer, NewErrLengthOverflow(length, lengthSize)
+		}
+
+		return AppendOrderedT(buffer, uint8(length), order)
+	case 2:
+		if length > math.MaxUint16 {
+			return buffer, NewErrLengthOverflow(length, lengthSize)
+		}
+
+		return AppendOrderedT(buffer, uint16(length), order)
+	case 4:
+		if uint64(length) > math.MaxUint32 {
+			return buffer, NewErrLengthOverflow(length, lengthSize)
+		}
+
+		return AppendOrderedT(buffer, uint32(length), order)
+	case 8:
+		return AppendOrderedT(buffer, uint64(length), order)
+	}
+
+	return buffer, NewErrInvalidLengthSize(lengthSize)
+}
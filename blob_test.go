@@ -0,0 +1,107 @@
+package buffergenerics
+
+import (
+	"encoding/binary"
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/stretchr/testify/assert"
+	"io"
+	"testing"
+)
+
+func TestReadBlobOrdered(t *testing.T) {
+	t.Run("it should read blobs with each prefix size", func(t *testing.T) {
+		for _, lengthSize := range []int{1, 2, 4, 8} {
+			order := binary.LittleEndian
+			want := []byte(gofakeit.LetterN(20))
+			buf := appendString(t, []byte{0xAA}, string(want), lengthSize, order)
+
+			got, n, err := ReadBlobOrdered(buf, 1, lengthSize, order)
+
+			assert.NoError(t, err, "it should not return an error")
+			assert.Equal(t, want, got)
+			assert.Equal(t, lengthSize+len(want), n)
+		}
+	})
+
+	t.Run("it should return a copy of the blob", func(t *testing.T) {
+		buf := appendString(t, nil, "\xDE\xAD", 1, binary.BigEndian)
+
+		got, _, _ := ReadBlobOrdered(buf, 0, 1, binary.BigEndian)
+		buf[1] = 0x00
+
+		assert.Equal(t, []byte{0xDE, 0xAD}, got)
+	})
+
+	t.Run("it should read an empty blob", func(t *testing.T) {
+		buf := appendString(t, nil, "", 2, binary.BigEndian)
+
+		got, n, err := ReadBlobOrdered(buf, 0, 2, binary.BigEndian)
+
+		assert.NoError(t, err, "it should not return an error")
+		assert.NotNil(t, got)
+		assert.Empty(t, got)
+		assert.Equal(t, 2, n)
+	})
+
+	t.Run("it should return an EOF error if the prefix exceeds the remaining buffer", func(t *testing.T) {
+		buf := appendString(t, nil, "hello", 4, binary.BigEndian)
+
+		_, _, err := ReadBlobOrdered(buf[:len(buf)-1], 0, 4, binary.BigEndian)
+
+		assert.ErrorIs(t, err, io.EOF)
+	})
+
+	t.Run("it should return ErrInvalidLengthSize for unsupported prefix sizes", func(t *testing.T) {
+		_, _, err := ReadBlobOrdered(make([]byte, 8), 0, 5, nil)
+
+		var errSize ErrInvalidLengthSize
+		assert.ErrorAs(t, err, &errSize)
+	})
+}
+
+func TestAppendBlobOrdered(t *testing.T) {
+	t.Run("it should round-trip with ReadBlobOrdered for each prefix size", func(t *testing.T) {
+		for _, lengthSize := range []int{1, 2, 4, 8} {
+			order := binary.BigEndian
+			want := []byte(gofakeit.LetterN(32))
+
+			buf, err := AppendBlobOrdered([]byte{0xAA}, want, lengthSize, order)
+			assert.NoError(t, err, "it should not return an error")
+			assert.Len(t, buf, 1+lengthSize+len(want))
+
+			got, n, err := ReadBlobOrdered(buf, 1, lengthSize, order)
+
+			assert.NoError(t, err, "it should not return an error")
+			assert.Equal(t, want, got)
+			assert.Equal(t, lengthSize+len(want), n)
+		}
+	})
+
+	t.Run("it should accept the largest length the prefix can represent", func(t *testing.T) {
+		buf, err := AppendBlobOrdered(nil, make([]byte, 255), 1, nil)
+
+		assert.NoError(t, err, "it should not return an error")
+		assert.Equal(t, byte(255), buf[0])
+	})
+
+	t.Run("it should return ErrLengthOverflow if the blob is too long for the prefix", func(t *testing.T) {
+		buf := []byte{0xAA}
+
+		for _, tc := range []struct{ length, lengthSize int }{{256, 1}, {65536, 2}} {
+			got, err := AppendBlobOrdered(buf, make([]byte, tc.length), tc.lengthSize, nil)
+
+			var errLength ErrLengthOverflow
+			assert.ErrorAs(t, err, &errLength)
+			assert.Equal(t, tc.length, errLength.Length)
+			assert.Equal(t, tc.lengthSize, errLength.Size)
+			assert.Equal(t, []byte{0xAA}, got, "it should return the original buffer")
+		}
+	})
+
+	t.Run("it should return ErrInvalidLengthSize for unsupported prefix sizes", func(t *testing.T) {
+		_, err := AppendBlobOrdered(nil, []byte{0x01}, 3, nil)
+
+		var errSize ErrInvalidLengthSize
+		assert.ErrorAs(t, err, &errSize)
+	})
+}
@@ -0,0 +1,31 @@
+package buffergenerics
+
+import (
+	"io"
+)
+
+// ReadBool reads a single byte from the given buffer at the specified offset and reports whether it is non-zero.
+// It returns io.EOF if the offset is outside the buffer.
+func ReadBool(buffer []byte, offset int) (bool, error) {
+	if offset < 0 || offset >= len(buffer) {
+		return false, io.EOF
+	}
+
+	return buffer[offset] != 0, nil
+}
+
+// WriteBool writes a single byte to the given buffer at the specified offset: 1 for true and 0 for false.
+// It returns io.EOF if the offset is outside the buffer.
+func WriteBool(buffer []byte, offset int, value bool) error {
+	if offset < 0 || offset >= len(buffer) {
+		return io.EOF
+	}
+
+	if value {
+		buffer[offset] = 1
+	} else {
+		buffer[offset] = 0
+	}
+
+	return nil
+}
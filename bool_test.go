@@ -0,0 +1,65 @@
+package buffergenerics
+
+import (
+	"github.com/stretchr/testify/assert"
+	"io"
+	"testing"
+)
+
+func TestReadBool(t *testing.T) {
+	t.Run("it should return false for a zero byte", func(t *testing.T) {
+		b, err := ReadBool([]byte{0x00}, 0)
+
+		assert.NoError(t, err, "it should not return an error")
+		assert.False(t, b)
+	})
+
+	t.Run("it should return true for any non-zero byte", func(t *testing.T) {
+		for _, v := range []byte{0x01, 0x7F, 0xFF} {
+			b, err := ReadBool([]byte{0x00, v}, 1)
+
+			assert.NoError(t, err, "it should not return an error")
+			assert.True(t, b)
+		}
+	})
+
+	t.Run("it should return an EOF error for out-of-bounds reads", func(t *testing.T) {
+		buf := []byte{0x01}
+
+		_, err := ReadBool(buf, len(buf))
+		assert.ErrorIs(t, err, io.EOF)
+
+		_, err = ReadBool(buf, -1)
+		assert.ErrorIs(t, err, io.EOF)
+	})
+}
+
+func TestWriteBool(t *testing.T) {
+	t.Run("it should write 1 for true and 0 for false", func(t *testing.T) {
+		buf := []byte{0xFF, 0x00}
+
+		assert.NoError(t, WriteBool(buf, 0, false))
+		assert.NoError(t, WriteBool(buf, 1, true))
+
+		assert.Equal(t, []byte{0x00, 0x01}, buf)
+	})
+
+	t.Run("it should round-trip with ReadBool", func(t *testing.T) {
+		buf := make([]byte, 1)
+
+		for _, want := range []bool{true, false} {
+			assert.NoError(t, WriteBool(buf, 0, want))
+			got, err := ReadBool(buf, 0)
+
+			assert.NoError(t, err, "it should not return an error")
+			assert.Equal(t, want, got)
+		}
+	})
+
+	t.Run("it should return an EOF error for out-of-bounds writes", func(t *testing.T) {
+		buf := []byte{0x01}
+
+		assert.ErrorIs(t, WriteBool(buf, len(buf), true), io.EOF)
+		assert.Equal(t, []byte{0x01}, buf)
+	})
+}
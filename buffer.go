@@ -0,0 +1,84 @@
+package buffergenerics
+
+import (
+	"encoding/binary"
+)
+
+// Buffer is a growable byte buffer with independent read and write cursors and a default byte order.
+// Values are appended at the write cursor with AppendTo and consumed from the read cursor with ReadFrom;
+// reads never pass the written region. The zero value is an empty buffer using binary.NativeEndian.
+type Buffer struct {
+	data  []byte
+	read  int
+	write int
+	order binary.ByteOrder
+}
+
+// NewBuffer returns a Buffer whose written region is initialized to data, using the specified byte order
+// for all reads and writes. If the byte order is nil, it defaults to binary.NativeEndian.
+// The Buffer takes ownership of data; the caller should not use it afterward.
+func NewBuffer(data []byte, order binary.ByteOrder) *Buffer {
+	return &Buffer{
+		data:  data,
+		write: len(data),
+		order: order,
+	}
+}
+
+// Bytes returns the written region of the buffer. The result aliases the buffer contents and is only valid
+// until the next write.
+func (b *Buffer) Bytes() []byte {
+	return b.data[:b.write]
+}
+
+// Len returns the number of written bytes that have not yet been read.
+func (b *Buffer) Len() int {
+	return b.write - b.read
+}
+
+// ReadOffset returns the position of the read cursor.
+func (b *Buffer) ReadOffset() int {
+	return b.read
+}
+
+// WriteOffset returns the position of the write cursor, which is also the size of the written region.
+func (b *Buffer) WriteOffset() int {
+	return b.write
+}
+
+// Order returns the byte order used by the buffer.
+func (b *Buffer) Order() binary.ByteOrder {
+	return b.order
+}
+
+// ReadFrom reads a value of type T at the read cursor of the given Buffer and advances the cursor past it.
+// It returns io.EOF, without advancing, if the value would extend past the written region.
+// See also: ReadOrderedT.
+func ReadFrom[T Numeric](b *Buffer) (T, error) {
+	val, err := ReadOrderedT[T](b.Bytes(), b.read, b.order)
+	if err != nil {
+		return val, err
+	}
+
+	b.read += SizeOf[T]()
+	return val, nil
+}
+
+// AppendTo writes a value of type T at the write cursor of the given Buffer, growing it as needed,
+// and advances the write cursor past it. It returns any error encountered during the write operation.
+// See also: WriteOrderedT.
+func AppendTo[T Numeric](b *Buffer, v T) error {
+	size := SizeOf[T]()
+
+	if b.write+size > len(b.data) {
+		b.data = append(b.data[:b.write], make([]byte, size)...)
+		b.data = b.data[:cap(b.data)]
+	}
+
+	if err := WriteOrderedT[T](b.data, b.write, v, b.order); err != nil {
+		return err
+	}
+
+	b.write += size
+	return nil
+}
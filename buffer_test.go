@@ -0,0 +1,83 @@
+package buffergenerics
+
+import (
+	"encoding/binary"
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/stretchr/testify/assert"
+	"io"
+	"testing"
+)
+
+func TestBuffer(t *testing.T) {
+	t.Run("it should interleave writes and reads with independent cursors", func(t *testing.T) {
+		b := NewBuffer(nil, binary.BigEndian)
+		want16, want32, want64 := gofakeit.Uint16(), gofakeit.Int32(), gofakeit.Float64()
+
+		assert.NoError(t, AppendTo(b, want16))
+		assert.NoError(t, AppendTo(b, want32))
+
+		got16, err := ReadFrom[uint16](b)
+		assert.NoError(t, err, "it should not return an error")
+		assert.Equal(t, want16, got16)
+		assert.Equal(t, 2, b.ReadOffset())
+		assert.Equal(t, 6, b.WriteOffset())
+
+		assert.NoError(t, AppendTo(b, want64))
+		assert.Equal(t, 2, b.ReadOffset(), "it should not move the read cursor on write")
+
+		got32, err := ReadFrom[int32](b)
+		assert.NoError(t, err, "it should not return an error")
+		assert.Equal(t, want32, got32)
+
+		got64, err := ReadFrom[float64](b)
+		assert.NoError(t, err, "it should not return an error")
+		assert.Equal(t, want64, got64)
+
+		assert.Equal(t, 14, b.ReadOffset())
+		assert.Equal(t, 14, b.WriteOffset())
+		assert.Zero(t, b.Len())
+	})
+
+	t.Run("it should use the buffer byte order", func(t *testing.T) {
+		b := NewBuffer(nil, binary.LittleEndian)
+
+		assert.NoError(t, AppendTo[uint32](b, 0xCAFEBABE))
+
+		assert.Equal(t, []byte{0xBE, 0xBA, 0xFE, 0xCA}, b.Bytes())
+		assert.Equal(t, binary.LittleEndian, b.Order())
+	})
+
+	t.Run("it should read initial data as the written region", func(t *testing.T) {
+		b := NewBuffer([]byte{0xDE, 0xAD}, binary.BigEndian)
+
+		val, err := ReadFrom[uint16](b)
+
+		assert.NoError(t, err, "it should not return an error")
+		assert.Equal(t, uint16(0xDEAD), val)
+	})
+
+	t.Run("it should return an EOF error when reading past the written region", func(t *testing.T) {
+		b := NewBuffer(make([]byte, 0, 64), binary.BigEndian)
+		assert.NoError(t, AppendTo[uint16](b, 0xDEAD))
+
+		_, err := ReadFrom[uint32](b)
+
+		assert.ErrorIs(t, err, io.EOF)
+		assert.Equal(t, 0, b.ReadOffset(), "it should not advance on error")
+	})
+
+	t.Run("it should grow to hold many writes", func(t *testing.T) {
+		var b Buffer
+		for i := 0; i < 100; i++ {
+			assert.NoError(t, AppendTo(&b, uint64(i)))
+		}
+
+		for i := 0; i < 100; i++ {
+			val, err := ReadFrom[uint64](&b)
+			assert.NoError(t, err, "it should not return an error")
+			assert.Equal(t, uint64(i), val)
+		}
+
+		assert.Len(t, b.Bytes(), 800)
+	})
+}
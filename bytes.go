@@ -0,0 +1,72 @@
+package buffergenerics
+
+import (
+	"bytes"
+	"io"
+)
+
+// Clone returns an independent copy of the given buffer, such that modifying either does not affect the other.
+// A nil buffer yields nil, while an empty non-nil buffer yields an empty non-nil copy.
+// It is the canonical way to take a defensive copy of buffer contents within this package.
+func Clone(buffer []byte) []byte {
+	return bytes.Clone(buffer)
+}
+
+// Equal reports whether a and b have the same length and contain the same bytes.
+// A nil buffer is considered equal to an empty buffer.
+func Equal(a, b []byte) bool {
+	return bytes.Equal(a, b)
+}
+
+// ReadFixedBytesInto copies exactly len(dst) bytes from the given buffer starting at the specified offset into dst.
+// It is intended for fixed-width identifiers and hashes, read into an array via dst[:].
+// It returns io.EOF if the buffer does not hold len(dst) bytes at offset, in which case dst is left untouched.
+func ReadFixedBytesInto(buffer []byte, offset int, dst []byte) error {
+	if offset < 0 || offset > len(buffer)-len(dst) {
+		return io.EOF
+	}
+
+	copy(dst, buffer[offset:offset+len(dst)])
+	return nil
+}
+
+// ReadBytes reads n bytes from the given buffer starting at the specified offset.
+// It returns a copy of the bytes, such that modifying the buffer afterward does not affect the result,
+// and io.EOF if the buffer does not hold n bytes at offset.
+// See also: Clone.
+func ReadBytes(buffer []byte, offset, n int) ([]byte, error) {
+	if offset < 0 || n < 0 || offset > len(buffer)-n {
+		return nil, io.EOF
+	}
+
+	return Clone(buffer[offset : offset+n]), nil
+}
+
+// MustReadBytes reads n bytes from the given buffer starting at the specified offset.
+// It returns a copy of the bytes. If an error is encountered during the read operation, it panics with the error.
+// See also: ReadBytes.
+func MustReadBytes(buffer []byte, offset, n int) []byte {
+	val, err := ReadBytes(buffer, offset, n)
+	if err != nil {
+		panic(err)
+	}
+
+	return val
+}
+
+// ExpectZero checks that the length bytes of the given buffer starting at the specified offset are all zero,
+// as is required of reserved fields by many protocols. It returns io.EOF if the region lies outside the buffer
+// and ErrReservedNonZero, carrying the offset of the first non-zero byte, if any byte is non-zero.
+func ExpectZero(buffer []byte, offset, length int) error {
+	if offset < 0 || length < 0 || offset > len(buffer)-length {
+		return io.EOF
+	}
+
+	for i, b := range buffer[offset : offset+length] {
+		if b != 0 {
+			return NewErrReservedNonZero(offset+i, b)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,144 @@
+package buffergenerics
+
+import (
+	"github.com/stretchr/testify/assert"
+	"io"
+	"testing"
+)
+
+func TestClone(t *testing.T) {
+	t.Run("it should return an independent copy", func(t *testing.T) {
+		buf := []byte{0xDE, 0xAD, 0xCA, 0xFE}
+
+		clone := Clone(buf)
+		buf[0] = 0x00
+
+		assert.Equal(t, []byte{0xDE, 0xAD, 0xCA, 0xFE}, clone)
+	})
+
+	t.Run("it should return nil for a nil buffer", func(t *testing.T) {
+		assert.Nil(t, Clone(nil))
+	})
+
+	t.Run("it should return an empty non-nil copy for an empty buffer", func(t *testing.T) {
+		clone := Clone([]byte{})
+
+		assert.NotNil(t, clone)
+		assert.Empty(t, clone)
+	})
+}
+
+func TestEqual(t *testing.T) {
+	t.Run("it should report equal contents as equal", func(t *testing.T) {
+		assert.True(t, Equal([]byte{0xDE, 0xAD}, []byte{0xDE, 0xAD}))
+	})
+
+	t.Run("it should report differing contents as not equal", func(t *testing.T) {
+		assert.False(t, Equal([]byte{0xDE, 0xAD}, []byte{0xDE, 0xAF}))
+		assert.False(t, Equal([]byte{0xDE, 0xAD}, []byte{0xDE}))
+	})
+
+	t.Run("it should treat nil and empty buffers as equal", func(t *testing.T) {
+		assert.True(t, Equal(nil, nil))
+		assert.True(t, Equal(nil, []byte{}))
+		assert.True(t, Equal([]byte{}, nil))
+	})
+}
+
+func TestReadFixedBytesInto(t *testing.T) {
+	t.Run("it should read a 16-byte UUID into an array", func(t *testing.T) {
+		want := [16]byte{
+			0x12, 0x3E, 0x45, 0x67, 0xE8, 0x9B, 0x12, 0xD3,
+			0xA4, 0x56, 0x42, 0x66, 0x14, 0x17, 0x40, 0x00,
+		}
+		buf := append([]byte{0xFF, 0xFF}, want[:]...)
+
+		var got [16]byte
+		err := ReadFixedBytesInto(buf, 2, got[:])
+
+		assert.NoError(t, err, "it should not return an error")
+		assert.Equal(t, want, got)
+
+		buf[2] = 0x00
+		assert.Equal(t, byte(0x12), got[0], "it should copy rather than alias the buffer")
+	})
+
+	t.Run("it should return io.EOF for a too-short buffer", func(t *testing.T) {
+		buf := make([]byte, 15)
+
+		var got [16]byte
+		got[0] = 0xAA
+		err := ReadFixedBytesInto(buf, 0, got[:])
+
+		assert.ErrorIs(t, err, io.EOF)
+		assert.Equal(t, byte(0xAA), got[0], "it should leave dst untouched")
+	})
+
+	t.Run("it should return io.EOF for an out-of-range offset", func(t *testing.T) {
+		var got [4]byte
+
+		assert.ErrorIs(t, ReadFixedBytesInto(make([]byte, 8), 5, got[:]), io.EOF)
+		assert.ErrorIs(t, ReadFixedBytesInto(make([]byte, 8), -1, got[:]), io.EOF)
+	})
+}
+
+func TestReadBytes(t *testing.T) {
+	t.Run("it should return a copy of the requested bytes", func(t *testing.T) {
+		buf := []byte{0x00, 0xDE, 0xAD, 0xBE, 0xEF}
+
+		got, err := ReadBytes(buf, 1, 3)
+		buf[1] = 0x00
+
+		assert.NoError(t, err, "it should not return an error")
+		assert.Equal(t, []byte{0xDE, 0xAD, 0xBE}, got)
+	})
+
+	t.Run("it should return io.EOF for out-of-range reads", func(t *testing.T) {
+		buf := make([]byte, 4)
+
+		for _, tc := range []struct{ offset, n int }{{2, 3}, {-1, 1}, {0, -1}, {5, 0}} {
+			_, err := ReadBytes(buf, tc.offset, tc.n)
+			assert.ErrorIs(t, err, io.EOF, "offset %d, n %d", tc.offset, tc.n)
+		}
+	})
+}
+
+func TestMustReadBytes(t *testing.T) {
+	t.Run("it should return the requested bytes", func(t *testing.T) {
+		assert.Equal(t, []byte{0xAD, 0xBE}, MustReadBytes([]byte{0xDE, 0xAD, 0xBE, 0xEF}, 1, 2))
+	})
+
+	t.Run("it should panic on short input", func(t *testing.T) {
+		assert.PanicsWithError(t, "EOF", func() {
+			MustReadBytes([]byte{0xDE, 0xAD}, 1, 2)
+		})
+	})
+}
+
+func TestExpectZero(t *testing.T) {
+	t.Run("it should accept an all-zero region", func(t *testing.T) {
+		buf := []byte{0xFF, 0x00, 0x00, 0x00, 0xFF}
+
+		assert.NoError(t, ExpectZero(buf, 1, 3))
+		assert.NoError(t, ExpectZero(buf, 5, 0))
+	})
+
+	t.Run("it should return ErrReservedNonZero with the first non-zero offset", func(t *testing.T) {
+		buf := []byte{0xFF, 0x00, 0x00, 0x04, 0x00, 0x08}
+
+		err := ExpectZero(buf, 1, 5)
+
+		var errReserved ErrReservedNonZero
+		assert.ErrorAs(t, err, &errReserved)
+		assert.Equal(t, 3, errReserved.Offset)
+		assert.Equal(t, byte(0x04), errReserved.Value)
+	})
+
+	t.Run("it should return io.EOF for an out-of-range length", func(t *testing.T) {
+		buf := make([]byte, 4)
+
+		assert.ErrorIs(t, ExpectZero(buf, 1, 4), io.EOF)
+		assert.ErrorIs(t, ExpectZero(buf, -1, 2), io.EOF)
+		assert.ErrorIs(t, ExpectZero(buf, 0, -1), io.EOF)
+	})
+}
@@ -0,0 +1,74 @@
+package buffergenerics
+
+import (
+	"encoding/binary"
+	"io"
+)
+
+// ReadCheckedFrame reads a frame of length payload bytes from the given buffer starting at the specified offset,
+// followed by a single trailing checksum byte. It verifies that the checksum byte matches checksumFn applied to
+// the payload and returns a copy of the payload. It returns io.EOF if the frame extends past the buffer and
+// ErrChecksumMismatch if the checksum does not match, with Expected set to the trailing checksum byte
+// and Actual set to the checksum computed over the payload.
+func ReadCheckedFrame(buffer []byte, offset, length int, checksumFn func([]byte) byte) ([]byte, error) {
+	if offset < 0 || length < 0 || length >= len(buffer)-offset {
+		return nil, io.EOF
+	}
+
+	end := offset + length
+	payload := buffer[offset:end]
+
+	if sum := checksumFn(payload); sum != buffer[end] {
+		return nil, NewErrChecksumMismatch(buffer[end], sum)
+	}
+
+	return Clone(payload), nil
+}
+
+// ChecksumWriter maintains a running single-byte checksum over the bytes appended to a Writer, so that a frame's
+// trailing checksum is computed while it is built rather than in a second pass over the payload.
+type ChecksumWriter struct {
+	*Writer
+	update func(sum byte, data []byte) byte
+	sum    byte
+}
+
+// NewChecksumWriter returns a ChecksumWriter covering the bytes appended to w from now on, using update to fold
+// newly written bytes into the running checksum, for example by XOR or addition, starting from zero.
+// Values are written through the embedded Writer as usual, for example with WriteNext, and each is folded in as it
+// is appended. A Writer is covered by at most one ChecksumWriter; creating another for w replaces the first.
+func NewChecksumWriter(w *Writer, update func(sum byte, data []byte) byte) *ChecksumWriter {
+	c := &ChecksumWriter{
+		Writer: w,
+		update: update,
+	}
+	w.onAppend = c.fold
+
+	return c
+}
+
+// fold folds data, newly appended to the Writer, into the running checksum.
+func (c *ChecksumWriter) fold(data []byte) {
+	c.sum = c.update(c.sum, data)
+}
+
+// Reset reinitializes the embedded Writer to append to the given buffer using the specified byte order,
+// and restarts the checksum from zero. If the byte order is nil, it defaults to binary.NativeEndian.
+func (c *ChecksumWriter) Reset(buffer []byte, order binary.ByteOrder) {
+	c.Writer.Reset(buffer, order)
+	c.sum = 0
+}
+
+// Sum returns the checksum of the bytes written since the ChecksumWriter was created, reset, or last finalized.
+func (c *ChecksumWriter) Sum() byte {
+	return c.sum
+}
+
+// Finalize appends the checksum byte to the buffer and returns the buffer, ready to be verified with
+// ReadCheckedFrame. The checksum then restarts from zero after the appended byte, so frames can be chained.
+func (c *ChecksumWriter) Finalize() []byte {
+	c.buffer = append(c.buffer, c.sum)
+	c.sum = 0
+
+	return c.buffer
+}
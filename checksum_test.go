@@ -0,0 +1,167 @@
+package buffergenerics
+
+import (
+	"encoding/binary"
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/stretchr/testify/assert"
+	"io"
+	"testing"
+)
+
+func xorChecksum(payload []byte) byte {
+	var sum byte
+	for _, b := range payload {
+		sum ^= b
+	}
+
+	return sum
+}
+
+func sumChecksum(payload []byte) byte {
+	var sum byte
+	for _, b := range payload {
+		sum += b
+	}
+
+	return sum
+}
+
+func TestReadCheckedFrame(t *testing.T) {
+	t.Run("it should return the payload when the checksum matches", func(t *testing.T) {
+		buf := []byte{0xAA, 0x01, 0x02, 0x04, 0x07, 0xBB}
+
+		payload, err := ReadCheckedFrame(buf, 1, 3, xorChecksum)
+
+		assert.NoError(t, err, "it should not return an error")
+		assert.Equal(t, []byte{0x01, 0x02, 0x04}, payload)
+	})
+
+	t.Run("it should support additive checksums", func(t *testing.T) {
+		buf := []byte{0xFF, 0x02, 0x01}
+
+		payload, err := ReadCheckedFrame(buf, 0, 2, sumChecksum)
+
+		assert.NoError(t, err, "it should not return an error")
+		assert.Equal(t, []byte{0xFF, 0x02}, payload)
+	})
+
+	t.Run("it should return a copy of the payload", func(t *testing.T) {
+		buf := []byte{0x01, 0x02, 0x03}
+
+		payload, _ := ReadCheckedFrame(buf, 0, 2, xorChecksum)
+		buf[0] = 0xFF
+
+		assert.Equal(t, []byte{0x01, 0x02}, payload)
+	})
+
+	t.Run("it should return ErrChecksumMismatch for a corrupted payload", func(t *testing.T) {
+		buf := []byte{0x01, 0x02, 0x05, 0x07}
+
+		payload, err := ReadCheckedFrame(buf, 0, 3, xorChecksum)
+
+		var errMismatch ErrChecksumMismatch
+		assert.ErrorAs(t, err, &errMismatch)
+		assert.Equal(t, byte(0x07), errMismatch.Expected)
+		assert.Equal(t, byte(0x06), errMismatch.Actual)
+		assert.Nil(t, payload)
+	})
+
+	t.Run("it should return an EOF error for out-of-range lengths", func(t *testing.T) {
+		buf := []byte{0x01, 0x02, 0x03}
+
+		_, err := ReadCheckedFrame(buf, 0, 3, xorChecksum)
+		assert.ErrorIs(t, err, io.EOF, "it should require room for the checksum byte")
+
+		_, err = ReadCheckedFrame(buf, 2, 4, xorChecksum)
+		assert.ErrorIs(t, err, io.EOF)
+
+		_, err = ReadCheckedFrame(buf, 0, -1, xorChecksum)
+		assert.ErrorIs(t, err, io.EOF)
+	})
+}
+
+func xorUpdate(sum byte, data []byte) byte {
+	return sum ^ xorChecksum(data)
+}
+
+func sumUpdate(sum byte, data []byte) byte {
+	return sum + sumChecksum(data)
+}
+
+func TestChecksumWriter(t *testing.T) {
+	t.Run("it should match a fresh pass over the payload", func(t *testing.T) {
+		for _, tc := range []struct {
+			update     func(byte, []byte) byte
+			checksumFn func([]byte) byte
+		}{{xorUpdate, xorChecksum}, {sumUpdate, sumChecksum}} {
+			w := NewWriter(nil, binary.BigEndian)
+			_ = WriteNext[uint8](w, 0x7E) // start marker, outside the checksum
+			c := NewChecksumWriter(w, tc.update)
+
+			assert.NoError(t, WriteNext(w, gofakeit.Uint16()))
+			_ = c.Sum()
+			assert.NoError(t, WriteNext(c.Writer, gofakeit.Uint32()))
+			assert.NoError(t, WriteNext(c.Writer, gofakeit.Float64()))
+
+			frame := c.Finalize()
+
+			assert.Len(t, frame, 1+2+4+8+1)
+			assert.Equal(t, tc.checksumFn(frame[1:len(frame)-1]), frame[len(frame)-1])
+			payload, err := ReadCheckedFrame(frame, 1, len(frame)-2, tc.checksumFn)
+			assert.NoError(t, err, "it should not return an error")
+			assert.Equal(t, frame[1:len(frame)-1], payload)
+		}
+	})
+
+	t.Run("it should restart the checksum after finalizing", func(t *testing.T) {
+		c := NewChecksumWriter(NewWriter(nil, nil), xorUpdate)
+		_ = WriteNext[uint8](c.Writer, 0x0F)
+		first := c.Finalize()
+		assert.Equal(t, []byte{0x0F, 0x0F}, first)
+
+		_ = WriteNext[uint8](c.Writer, 0xF0)
+		frame := c.Finalize()
+
+		assert.Equal(t, []byte{0x0F, 0x0F, 0xF0, 0xF0}, frame)
+	})
+
+	t.Run("it should restart the checksum after a reset", func(t *testing.T) {
+		c := NewChecksumWriter(NewWriter(nil, nil), xorUpdate)
+		_ = WriteNext[uint32](c.Writer, 0x01020304)
+
+		c.Reset(nil, nil)
+		_ = WriteNext[uint8](c.Writer, 0xAA)
+
+		assert.Equal(t, []byte{0xAA, 0xAA}, c.Finalize())
+	})
+
+	t.Run("it should not depend on offsets when the embedded Writer is reset", func(t *testing.T) {
+		c := NewChecksumWriter(NewWriter(nil, nil), xorUpdate)
+		_ = WriteNext[uint32](c.Writer, 0)
+		_ = c.Sum()
+
+		c.Writer.Reset(nil, nil)
+		_ = WriteNext[uint8](c.Writer, 0xAA)
+
+		assert.Equal(t, []byte{0xAA, 0xAA}, c.Finalize())
+	})
+
+	t.Run("it should cover plain WriteNext on the original Writer", func(t *testing.T) {
+		w := NewWriter(nil, binary.BigEndian)
+		c := NewChecksumWriter(w, xorUpdate)
+
+		assert.NoError(t, WriteNext[uint16](w, 0x1234))
+
+		assert.Equal(t, byte(0x26), c.Sum())
+		assert.Equal(t, []byte{0x12, 0x34, 0x26}, c.Finalize())
+	})
+
+	t.Run("it should not cover bytes written before it was created", func(t *testing.T) {
+		w := NewWriter(nil, nil)
+		_ = WriteNext[uint8](w, 0x7E)
+		c := NewChecksumWriter(w, xorUpdate)
+		_ = WriteNext[uint8](w, 0x01)
+
+		assert.Equal(t, []byte{0x7E, 0x01, 0x01}, c.Finalize())
+	})
+}
@@ -0,0 +1,109 @@
+package buffergenerics
+
+import (
+	"fmt"
+	"strings"
+)
+
+// hexdumpWidth is the number of bytes rendered on each line of a Hexdump.
+const hexdumpWidth = 16
+
+// Hexdump returns an encoding/hex-style dump of the window [offset-radius, offset+radius] of the given buffer,
+// clamped to the buffer bounds. Each line begins with the absolute offset of its first byte, and the byte at
+// offset is marked by enclosing it in square brackets. It returns an empty string if the window is empty.
+// It is intended as a diagnostics aid, e.g. to log the bytes surrounding a failed read.
+func Hexdump(buffer []byte, offset int, radius int) string {
+	radius = max(radius, 0)
+
+	// The window is clamped without computing offset±radius directly, which could overflow.
+	start, end := 0, len(buffer)
+	if offset > radius {
+		start = offset - radius
+	}
+	if offset < len(buffer)-radius-1 {
+		end = offset + radius + 1
+	}
+
+	if start >= end {
+		return ""
+	}
+
+	// The marker is only drawn if offset lies within the window, rather than around a padding cell.
+	marked := offset >= start && offset < end
+
+	var sb strings.Builder
+	for line := start; line < end; line += hexdumpWidth {
+		lineEnd := min(line+hexdumpWidth, end)
+		fmt.Fprintf(&sb, "%08x ", line)
+
+		for i := line; i < line+hexdumpWidth; i++ {
+			switch {
+			case marked && i == offset:
+				sb.WriteByte('[')
+			case marked && i-1 == offset && i-1 >= line:
+				sb.WriteByte(']')
+			default:
+				sb.WriteByte(' ')
+			}
+
+			if i < lineEnd {
+				fmt.Fprintf(&sb, "%02x", buffer[i])
+			} else {
+				sb.WriteString("  ")
+			}
+		}
+
+		if marked && line+hexdumpWidth-1 == offset {
+			sb.WriteByte(']')
+		} else {
+			sb.WriteByte(' ')
+		}
+
+		sb.WriteString(" |")
+		for _, b := range buffer[line:lineEnd] {
+			if b < 32 || b > 126 {
+				b = '.'
+			}
+			sb.WriteByte(b)
+		}
+		sb.WriteString("|\n")
+	}
+
+	return sb.String()
+}
+
+// diffRadius is the number of bytes on either side of the first difference included by DiffBytes.
+const diffRadius = 8
+
+// DiffBytes describes the first difference between a and b, or returns an empty string if they are equal.
+// The first line reports the differing offset and the byte from each side, such as "offset 5: a=0x3f b=0x40",
+// with a side that has ended reported as EOF and the lengths appended if they differ. It is followed by a Hexdump
+// of the surrounding bytes from each side, making it a convenient failure message for round-trip tests.
+func DiffBytes(a, b []byte) string {
+	offset := 0
+	for offset < len(a) && offset < len(b) && a[offset] == b[offset] {
+		offset++
+	}
+
+	if offset == len(a) && offset == len(b) {
+		return ""
+	}
+
+	byteAt := func(buffer []byte) string {
+		if offset < len(buffer) {
+			return fmt.Sprintf("%#02x", buffer[offset])
+		}
+
+		return "EOF"
+	}
+
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "offset %d: a=%s b=%s", offset, byteAt(a), byteAt(b))
+
+	if len(a) != len(b) {
+		fmt.Fprintf(&sb, " (len(a)=%d, len(b)=%d)", len(a), len(b))
+	}
+
+	fmt.Fprintf(&sb, "\na:\n%sb:\n%s", Hexdump(a, offset, diffRadius), Hexdump(b, offset, diffRadius))
+	return sb.String()
+}
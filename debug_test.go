@@ -0,0 +1,113 @@
+package buffergenerics
+
+import (
+	"github.com/stretchr/testify/assert"
+	"math"
+	"strings"
+	"testing"
+)
+
+func TestHexdump(t *testing.T) {
+	t.Run("it should mark the byte at the offset", func(t *testing.T) {
+		buf := []byte{0xDE, 0xAD, 0xCA, 0xFE, 0xBA, 0xBE}
+
+		dump := Hexdump(buf, 2, 1)
+
+		assert.Equal(t, "00000001  ad[ca]fe"+strings.Repeat("   ", 13)+"  |...|\n", dump)
+	})
+
+	t.Run("it should clamp the window to the start of the buffer", func(t *testing.T) {
+		buf := []byte("Hello, world!")
+
+		dump := Hexdump(buf, 0, 2)
+
+		assert.True(t, strings.HasPrefix(dump, "00000000 [48]65 6c "), "it should start at offset zero")
+		assert.True(t, strings.HasSuffix(dump, "|Hel|\n"), "it should only include the clamped window")
+	})
+
+	t.Run("it should clamp the window to the end of the buffer", func(t *testing.T) {
+		buf := []byte("Hello, world!")
+
+		dump := Hexdump(buf, len(buf)-1, 4)
+
+		assert.True(t, strings.HasPrefix(dump, "00000008  6f 72 6c 64[21]"), "it should mark the last byte")
+		assert.True(t, strings.HasSuffix(dump, "|orld!|\n"), "it should stop at the end of the buffer")
+	})
+
+	t.Run("it should close the marker on the last column of a line", func(t *testing.T) {
+		buf := make([]byte, 32)
+
+		dump := Hexdump(buf, 15, 15)
+
+		assert.Contains(t, dump, "00[00] |")
+	})
+
+	t.Run("it should span multiple lines for large windows", func(t *testing.T) {
+		buf := make([]byte, 64)
+
+		dump := Hexdump(buf, 32, 16)
+
+		assert.Equal(t, 3, strings.Count(dump, "\n"))
+		assert.Contains(t, dump, "00000020 [00]")
+	})
+
+	t.Run("it should dump the whole buffer for a huge radius", func(t *testing.T) {
+		buf := []byte("hello, world!")
+
+		dump := Hexdump(buf, 0, math.MaxInt)
+
+		assert.Equal(t, Hexdump(buf, 0, len(buf)), dump)
+		assert.Contains(t, dump, "|hello, world!|")
+		assert.Contains(t, Hexdump(buf, -5, math.MaxInt), "|hello, world!|", "it should not overflow for negative offsets")
+	})
+
+	t.Run("it should not draw a marker for an offset past the end", func(t *testing.T) {
+		buf := []byte{0xDE, 0xAD, 0xCA, 0xFE}
+
+		dump := Hexdump(buf, len(buf), 2)
+
+		assert.True(t, strings.HasPrefix(dump, "00000002  ca fe "))
+		assert.NotContains(t, dump, "[")
+		assert.NotContains(t, dump, "]")
+	})
+
+	t.Run("it should return an empty string for windows outside the buffer", func(t *testing.T) {
+		buf := []byte{0xDE, 0xAD, 0xCA, 0xFE}
+
+		assert.Empty(t, Hexdump(buf, 100, 2))
+		assert.Empty(t, Hexdump(nil, 0, 2))
+	})
+}
+
+func TestDiffBytes(t *testing.T) {
+	t.Run("it should return an empty string for equal slices", func(t *testing.T) {
+		assert.Empty(t, DiffBytes([]byte{0xDE, 0xAD}, []byte{0xDE, 0xAD}))
+		assert.Empty(t, DiffBytes(nil, []byte{}))
+	})
+
+	t.Run("it should describe a single-byte difference", func(t *testing.T) {
+		a := []byte{0x00, 0x01, 0x02, 0x03, 0x04, 0x3f, 0x06}
+		b := []byte{0x00, 0x01, 0x02, 0x03, 0x04, 0x40, 0x06}
+
+		diff := DiffBytes(a, b)
+
+		assert.True(t, strings.HasPrefix(diff, "offset 5: a=0x3f b=0x40\n"), diff)
+		assert.Contains(t, diff, "a:\n00000000  00 01 02 03 04[3f]06")
+		assert.Contains(t, diff, "b:\n00000000  00 01 02 03 04[40]06")
+	})
+
+	t.Run("it should describe a length mismatch", func(t *testing.T) {
+		a := []byte{0x01, 0x02, 0x03}
+		b := []byte{0x01, 0x02}
+
+		diff := DiffBytes(a, b)
+
+		assert.True(t, strings.HasPrefix(diff, "offset 2: a=0x03 b=EOF (len(a)=3, len(b)=2)\n"), diff)
+	})
+
+	t.Run("it should report the lengths when a difference precedes a length mismatch", func(t *testing.T) {
+		diff := DiffBytes([]byte{0x01}, []byte{0x02, 0x03})
+
+		assert.True(t, strings.HasPrefix(diff, "offset 0: a=0x01 b=0x02 (len(a)=1, len(b)=2)\n"), diff)
+	})
+}
@@ -0,0 +1,49 @@
+package buffergenerics
+
+import (
+	"encoding/binary"
+	"golang.org/x/exp/constraints"
+	"slices"
+)
+
+// ReadDeltaOrderedT reads count consecutive delta-encoded integers of type T from the given buffer starting at the
+// specified offset, using the specified byte order. If the byte order is nil, it defaults to binary.NativeEndian.
+// It returns the running sum of the deltas starting from base, which reconstructs the absolute values, and any
+// error encountered during the read operation. Sums wrap around on overflow, as with ordinary integer arithmetic.
+// See also: ReadSliceOrderedT, AppendDeltaOrderedT.
+func ReadDeltaOrderedT[T constraints.Integer](buffer []byte, offset, count int, base T, order binary.ByteOrder) ([]T, error) {
+	values, err := ReadSliceOrderedT[T](buffer, offset, count, order)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range values {
+		base += values[i]
+		values[i] = base
+	}
+
+	return values, nil
+}
+
+// AppendDeltaOrderedT appends the delta encoding of values to the given buffer, using the specified byte order.
+// If the byte order is nil, it defaults to binary.NativeEndian. The first delta is taken from base and each
+// following delta from the previous value; differences wrap around on overflow, so ReadDeltaOrderedT with the
+// same base always reproduces values. It returns the extended buffer and any error encountered during the write
+// operation; on error, the original buffer is returned.
+// See also: ReadDeltaOrderedT, AppendSliceOrderedT.
+func AppendDeltaOrderedT[T constraints.Integer](buffer []byte, values []T, base T, order binary.ByteOrder) ([]byte, error) {
+	offset := len(buffer)
+	grown := slices.Grow(buffer, len(values)*SizeOf[T]())[:offset]
+
+	prev := base
+	for _, v := range values {
+		var err error
+		if grown, err = AppendOrderedT[T](grown, v-prev, order); err != nil {
+			return buffer, err
+		}
+
+		prev = v
+	}
+
+	return grown, nil
+}
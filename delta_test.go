@@ -0,0 +1,68 @@
+package buffergenerics
+
+import (
+	"encoding/binary"
+	"github.com/stretchr/testify/assert"
+	"io"
+	"testing"
+)
+
+func TestReadDeltaOrderedT(t *testing.T) {
+	t.Run("it should reconstruct absolute values from deltas", func(t *testing.T) {
+		buf, _ := AppendSliceOrderedT(nil, []uint32{5, 10, 0, 25}, binary.BigEndian)
+
+		got, err := ReadDeltaOrderedT[uint32](buf, 0, 4, 1000, binary.BigEndian)
+
+		assert.NoError(t, err, "it should not return an error")
+		assert.Equal(t, []uint32{1005, 1015, 1015, 1040}, got)
+	})
+
+	t.Run("it should apply negative deltas for signed types", func(t *testing.T) {
+		buf, _ := AppendSliceOrderedT([]byte{0xFF}, []int16{3, -7, 2, -10}, binary.LittleEndian)
+
+		got, err := ReadDeltaOrderedT[int16](buf, 1, 4, 0, binary.LittleEndian)
+
+		assert.NoError(t, err, "it should not return an error")
+		assert.Equal(t, []int16{3, -4, -2, -12}, got)
+	})
+
+	t.Run("it should return an EOF error when the buffer is too short", func(t *testing.T) {
+		_, err := ReadDeltaOrderedT[uint16](make([]byte, 5), 0, 3, 0, nil)
+
+		assert.ErrorIs(t, err, io.EOF)
+	})
+}
+
+func TestAppendDeltaOrderedT(t *testing.T) {
+	t.Run("it should write successive differences", func(t *testing.T) {
+		buf, err := AppendDeltaOrderedT([]byte{0xAA}, []uint16{105, 110, 110, 200}, 100, binary.BigEndian)
+
+		assert.NoError(t, err, "it should not return an error")
+		assert.Equal(t, []byte{0xAA, 0x00, 0x05, 0x00, 0x05, 0x00, 0x00, 0x00, 0x5A}, buf)
+	})
+
+	t.Run("it should round-trip monotonic and non-monotonic sequences", func(t *testing.T) {
+		for _, want := range [][]int32{
+			{1, 2, 4, 8, 16},
+			{10, -3, 7, 7, -100, 2_000_000_000, -2_000_000_000},
+		} {
+			buf, err := AppendDeltaOrderedT(nil, want, 5, binary.LittleEndian)
+			assert.NoError(t, err, "it should not return an error")
+
+			got, err := ReadDeltaOrderedT[int32](buf, 0, len(want), 5, binary.LittleEndian)
+
+			assert.NoError(t, err, "it should not return an error")
+			assert.Equal(t, want, got)
+		}
+	})
+
+	t.Run("it should round-trip non-monotonic unsigned sequences by wrapping", func(t *testing.T) {
+		want := []uint8{200, 10, 255, 0}
+
+		buf, _ := AppendDeltaOrderedT(nil, want, 0, nil)
+		got, err := ReadDeltaOrderedT[uint8](buf, 0, len(want), 0, nil)
+
+		assert.NoError(t, err, "it should not return an error")
+		assert.Equal(t, want, got)
+	})
+}
@@ -0,0 +1,69 @@
+package buffergenerics
+
+import (
+	"encoding/binary"
+)
+
+// ReadNetT reads a value of type T in network byte order (big-endian) from the given buffer starting at the
+// specified offset. It returns the read value and any error encountered during the read operation.
+// See also: ReadOrderedT.
+func ReadNetT[T Numeric](buffer []byte, offset int) (T, error) {
+	return ReadOrderedT[T](buffer, offset, binary.BigEndian)
+}
+
+// AppendNetT appends the network byte order (big-endian) encoding of a value of type T to the given buffer.
+// It returns the extended buffer and any error encountered during the write operation.
+// See also: AppendOrderedT.
+func AppendNetT[T Numeric](buffer []byte, value T) ([]byte, error) {
+	return AppendOrderedT[T](buffer, value, binary.BigEndian)
+}
+
+// WriteNetT writes a value of type T in network byte order (big-endian) to the given buffer starting at the
+// specified offset. It returns any error encountered during the write operation.
+// See also: WriteOrderedT.
+func WriteNetT[T Numeric](buffer []byte, offset int, value T) error {
+	return WriteOrderedT[T](buffer, offset, value, binary.BigEndian)
+}
+
+// ReadLET reads a value of type T in little-endian byte order from the given buffer starting at the specified offset.
+// It returns the read value and any error encountered during the read operation.
+// See also: ReadOrderedT.
+func ReadLET[T Numeric](buffer []byte, offset int) (T, error) {
+	return ReadOrderedT[T](buffer, offset, binary.LittleEndian)
+}
+
+// AppendLET appends the little-endian encoding of a value of type T to the given buffer.
+// It returns the extended buffer and any error encountered during the write operation.
+// See also: AppendOrderedT.
+func AppendLET[T Numeric](buffer []byte, value T) ([]byte, error) {
+	return AppendOrderedT[T](buffer, value, binary.LittleEndian)
+}
+
+// WriteLET writes a value of type T in little-endian byte order to the given buffer starting at the specified offset.
+// It returns any error encountered during the write operation.
+// See also: WriteOrderedT.
+func WriteLET[T Numeric](buffer []byte, offset int, value T) error {
+	return WriteOrderedT[T](buffer, offset, value, binary.LittleEndian)
+}
+
+// ReadBET reads a value of type T in big-endian byte order from the given buffer starting at the specified offset.
+// It returns the read value and any error encountered during the read operation. It is equivalent to ReadNetT,
+// but reads more clearly for big-endian formats unrelated to networking.
+// See also: ReadOrderedT.
+func ReadBET[T Numeric](buffer []byte, offset int) (T, error) {
+	return ReadOrderedT[T](buffer, offset, binary.BigEndian)
+}
+
+// AppendBET appends the big-endian encoding of a value of type T to the given buffer.
+// It returns the extended buffer and any error encountered during the write operation.
+// See also: AppendOrderedT.
+func AppendBET[T Numeric](buffer []byte, value T) ([]byte, error) {
+	return AppendOrderedT[T](buffer, value, binary.BigEndian)
+}
+
+// WriteBET writes a value of type T in big-endian byte order to the given buffer starting at the specified offset.
+// It returns any error encountered during the write operation.
+// See also: WriteOrderedT.
+func WriteBET[T Numeric](buffer []byte, offset int, value T) error {
+	return WriteOrderedT[T](buffer, offset, value, binary.BigEndian)
+}
@@ -0,0 +1,91 @@
+package buffergenerics
+
+import (
+	"encoding/binary"
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/stretchr/testify/assert"
+	"io"
+	"testing"
+)
+
+// endianShorthands holds one family of fixed-order shorthands, instantiated at the types exercised by
+// doTestEndianShorthands_Order.
+type endianShorthands struct {
+	read32 func(buffer []byte, offset int) (int32, error)
+	read64 func(buffer []byte, offset int) (float64, error)
+	append func(buffer []byte, value uint64) ([]byte, error)
+	write  func(buffer []byte, offset int, value uint32) error
+}
+
+func doTestEndianShorthands_Order(t *testing.T, fns endianShorthands, order binary.ByteOrder) {
+	name := order.String()
+
+	t.Run("it should match ReadOrderedT with "+name, func(t *testing.T) {
+		buf := []byte{0xDE, 0xAD, 0xBE, 0xEF, 0xCA, 0xFE, 0xBA, 0xBE}
+
+		got32, err := fns.read32(buf, 3)
+		assert.NoError(t, err, "it should not return an error")
+		assert.Equal(t, MustReadOrderedT[int32](buf, 3, order), got32)
+
+		got64, err := fns.read64(buf, 0)
+		assert.NoError(t, err, "it should not return an error")
+		assert.Equal(t, MustReadOrderedT[float64](buf, 0, order), got64)
+	})
+
+	t.Run("it should return an EOF error for short "+name+" reads", func(t *testing.T) {
+		_, err := fns.read32([]byte{0x01, 0x02, 0x03}, 0)
+
+		assert.ErrorIs(t, err, io.EOF)
+	})
+
+	t.Run("it should match AppendOrderedT with "+name, func(t *testing.T) {
+		want := gofakeit.Uint64()
+
+		got, err := fns.append([]byte{0xAA}, want)
+		assert.NoError(t, err, "it should not return an error")
+		expected, _ := AppendOrderedT([]byte{0xAA}, want, order)
+
+		assert.Equal(t, expected, got)
+	})
+
+	t.Run("it should match WriteOrderedT with "+name, func(t *testing.T) {
+		want := gofakeit.Uint32()
+		got, expected := make([]byte, 6), make([]byte, 6)
+
+		assert.NoError(t, fns.write(got, 2, want))
+		_ = WriteOrderedT(expected, 2, want, order)
+
+		assert.Equal(t, expected, got)
+	})
+
+	t.Run("it should return an EOF error for short "+name+" writes", func(t *testing.T) {
+		assert.ErrorIs(t, fns.write(make([]byte, 4), 1, 1), io.EOF)
+	})
+}
+
+func TestEndianShorthands_Net(t *testing.T) {
+	doTestEndianShorthands_Order(t, endianShorthands{
+		read32: ReadNetT[int32],
+		read64: ReadNetT[float64],
+		append: AppendNetT[uint64],
+		write:  WriteNetT[uint32],
+	}, binary.BigEndian)
+}
+
+func TestEndianShorthands_LE(t *testing.T) {
+	doTestEndianShorthands_Order(t, endianShorthands{
+		read32: ReadLET[int32],
+		read64: ReadLET[float64],
+		append: AppendLET[uint64],
+		write:  WriteLET[uint32],
+	}, binary.LittleEndian)
+}
+
+func TestEndianShorthands_BE(t *testing.T) {
+	doTestEndianShorthands_Order(t, endianShorthands{
+		read32: ReadBET[int32],
+		read64: ReadBET[float64],
+		append: AppendBET[uint64],
+		write:  WriteBET[uint32],
+	}, binary.BigEndian)
+}
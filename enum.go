@@ -0,0 +1,26 @@
+package buffergenerics
+
+import (
+	"encoding/binary"
+	"fmt"
+	"golang.org/x/exp/constraints"
+	"slices"
+)
+
+// ReadEnumOrderedT reads an enumerated value of integer type T from the given buffer starting at the specified
+// offset, using the specified byte order. If the byte order is nil, it defaults to binary.NativeEndian.
+// It returns ErrInvalidEnum, carrying the formatted offending value and a description of valid, if the value read
+// is not one of valid.
+// See also: ReadOrderedT.
+func ReadEnumOrderedT[T constraints.Integer](buffer []byte, offset int, valid []T, order binary.ByteOrder) (T, error) {
+	val, err := ReadOrderedT[T](buffer, offset, order)
+	if err != nil {
+		return val, err
+	}
+
+	if !slices.Contains(valid, val) {
+		return *new(T), NewErrInvalidEnum(fmt.Sprint(val), fmt.Sprint(valid))
+	}
+
+	return val, nil
+}
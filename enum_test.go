@@ -0,0 +1,57 @@
+package buffergenerics
+
+import (
+	"encoding/binary"
+	"github.com/stretchr/testify/assert"
+	"io"
+	"testing"
+)
+
+type testColor uint16
+
+const (
+	testColorRed testColor = iota
+	testColorGreen
+	testColorBlue
+)
+
+var testColors = []testColor{testColorRed, testColorGreen, testColorBlue}
+
+func TestReadEnumOrderedT(t *testing.T) {
+	t.Run("it should return a valid value", func(t *testing.T) {
+		buf := []byte{0x00, 0x02}
+
+		color, err := ReadEnumOrderedT(buf, 0, testColors, binary.BigEndian)
+
+		assert.NoError(t, err, "it should not return an error")
+		assert.Equal(t, testColorBlue, color)
+	})
+
+	t.Run("it should return ErrInvalidEnum for a value outside the valid set", func(t *testing.T) {
+		buf := []byte{0x00, 0x07}
+
+		color, err := ReadEnumOrderedT(buf, 0, testColors, binary.BigEndian)
+
+		var errEnum ErrInvalidEnum
+		assert.ErrorAs(t, err, &errEnum)
+		assert.Equal(t, "7", errEnum.Value)
+		assert.Equal(t, "[0 1 2]", errEnum.Valid)
+		assert.Zero(t, color)
+	})
+
+	t.Run("it should return an EOF error for short buffers", func(t *testing.T) {
+		_, err := ReadEnumOrderedT([]byte{0x00}, 0, testColors, binary.BigEndian)
+
+		assert.ErrorIs(t, err, io.EOF)
+	})
+
+	t.Run("it should report unsigned values above math.MaxInt64 without wrapping", func(t *testing.T) {
+		buf := []byte{0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF}
+
+		_, err := ReadEnumOrderedT(buf, 0, []uint64{0, 1}, binary.BigEndian)
+
+		var errEnum ErrInvalidEnum
+		assert.ErrorAs(t, err, &errEnum)
+		assert.Equal(t, "18446744073709551615", errEnum.Value)
+	})
+}
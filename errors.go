@@ -1,6 +1,7 @@
 package buffergenerics
 
 import (
+	"encoding/binary"
 	"fmt"
 	"reflect"
 )
@@ -12,7 +13,355 @@ type ErrUnknownKind struct {
 
 func NewErrUnknownKind(kind reflect.Kind) ErrUnknownKind {
 	return ErrUnknownKind{
-		error: fmt.Errorf("unknown kind: %v", kind),
+		error: fmt.Errorf("unknown kind: %v, expected one of %v", kind, supportedKinds),
 		Kind:  kind,
 	}
 }
+
+type ErrInvalidBitCount struct {
+	error
+	Count int
+}
+
+func NewErrInvalidBitCount(count int) ErrInvalidBitCount {
+	return ErrInvalidBitCount{
+		error: fmt.Errorf("invalid bit count: %d, expected 0 to 64", count),
+		Count: count,
+	}
+}
+
+type ErrUnknownByteOrder struct {
+	error
+	Magic uint32
+}
+
+func NewErrUnknownByteOrder(magic uint32) ErrUnknownByteOrder {
+	return ErrUnknownByteOrder{
+		error: fmt.Errorf("unknown byte order: magic %#08x not found in either order", magic),
+		Magic: magic,
+	}
+}
+
+type ErrInvalidBOM struct {
+	error
+	Mark [2]byte
+}
+
+func NewErrInvalidBOM(mark [2]byte) ErrInvalidBOM {
+	return ErrInvalidBOM{
+		error: fmt.Errorf("invalid byte order mark: %q, expected \"II\" or \"MM\"", mark[:]),
+		Mark:  mark,
+	}
+}
+
+type ErrChecksumMismatch struct {
+	error
+	Expected byte
+	Actual   byte
+}
+
+func NewErrChecksumMismatch(expected, actual byte) ErrChecksumMismatch {
+	return ErrChecksumMismatch{
+		error:    fmt.Errorf("checksum mismatch: expected %#02x, got %#02x", expected, actual),
+		Expected: expected,
+		Actual:   actual,
+	}
+}
+
+type ErrInvalidStride struct {
+	error
+	Stride int
+	Size   int
+}
+
+func NewErrInvalidStride(stride, size int) ErrInvalidStride {
+	return ErrInvalidStride{
+		error:  fmt.Errorf("invalid stride: %d, expected at least the element size %d", stride, size),
+		Stride: stride,
+		Size:   size,
+	}
+}
+
+type ErrTrailingBytes struct {
+	error
+	Trailing int
+}
+
+func NewErrTrailingBytes(trailing int) ErrTrailingBytes {
+	return ErrTrailingBytes{
+		error:    fmt.Errorf("trailing bytes: %d bytes remain after the last whole element", trailing),
+		Trailing: trailing,
+	}
+}
+
+type ErrBigIntRange struct {
+	error
+	ByteLen int
+}
+
+func NewErrBigIntRange(byteLen int) ErrBigIntRange {
+	return ErrBigIntRange{
+		error:   fmt.Errorf("big integer out of range: value does not fit in %d unsigned bytes", byteLen),
+		ByteLen: byteLen,
+	}
+}
+
+type ErrBadMagic struct {
+	error
+	Expected uint32
+	Got      uint32
+}
+
+func NewErrBadMagic(expected, got uint32) ErrBadMagic {
+	return ErrBadMagic{
+		error:    fmt.Errorf("bad magic: expected 0x%08x, got 0x%08x", expected, got),
+		Expected: expected,
+		Got:      got,
+	}
+}
+
+type ErrField struct {
+	error
+	Field string
+	Err   error
+}
+
+func NewErrField(field string, err error) ErrField {
+	return ErrField{
+		error: fmt.Errorf("field %q: %w", field, err),
+		Field: field,
+		Err:   err,
+	}
+}
+
+func (e ErrField) Unwrap() error {
+	return e.Err
+}
+
+type ErrCountOverflow struct {
+	error
+	Count int
+	Size  int
+}
+
+func NewErrCountOverflow(count, size int) ErrCountOverflow {
+	return ErrCountOverflow{
+		error: fmt.Errorf("count overflow: %d elements of %d bytes exceed the maximum int", count, size),
+		Count: count,
+		Size:  size,
+	}
+}
+
+type ErrCountExceedsLimit struct {
+	error
+	Count int
+	Limit int
+}
+
+func NewErrCountExceedsLimit(count, limit int) ErrCountExceedsLimit {
+	return ErrCountExceedsLimit{
+		error: fmt.Errorf("count exceeds limit: %d elements requested, at most %d allowed", count, limit),
+		Count: count,
+		Limit: limit,
+	}
+}
+
+type ErrScanArg struct {
+	error
+	Index int
+	Type  reflect.Type
+}
+
+func NewErrScanArg(index int, typ reflect.Type) ErrScanArg {
+	return ErrScanArg{
+		error: fmt.Errorf("invalid scan argument %d: %v is not a non-nil pointer to a supported type", index, typ),
+		Index: index,
+		Type:  typ,
+	}
+}
+
+type ErrInvalidEnum struct {
+	error
+	Value string
+	Valid string
+}
+
+func NewErrInvalidEnum(value string, valid string) ErrInvalidEnum {
+	return ErrInvalidEnum{
+		error: fmt.Errorf("invalid enum value %s, expected one of %s", value, valid),
+		Value: value,
+		Valid: valid,
+	}
+}
+
+type ErrInvalidLengthSize struct {
+	error
+	Size int
+}
+
+func NewErrInvalidLengthSize(size int) ErrInvalidLengthSize {
+	return ErrInvalidLengthSize{
+		error: fmt.Errorf("invalid length prefix size: %d, expected 1, 2, 4, or 8", size),
+		Size:  size,
+	}
+}
+
+type ErrUnregisteredType struct {
+	error
+	Type reflect.Type
+}
+
+func NewErrUnregisteredType(typ reflect.Type) ErrUnregisteredType {
+	return ErrUnregisteredType{
+		error: fmt.Errorf("no decoder registered for type %v", typ),
+		Type:  typ,
+	}
+}
+
+type ErrOrderSliceLength struct {
+	error
+	Order binary.ByteOrder
+	Size  int
+	Err   error
+}
+
+func NewErrOrderSliceLength(order binary.ByteOrder, size int, err error) ErrOrderSliceLength {
+	return ErrOrderSliceLength{
+		error: fmt.Errorf("byte order %v failed to decode a %d-byte value: %w", order, size, err),
+		Order: order,
+		Size:  size,
+		Err:   err,
+	}
+}
+
+func (e ErrOrderSliceLength) Unwrap() error {
+	return e.Err
+}
+
+type ErrUnknownMark struct {
+	error
+	Name string
+}
+
+func NewErrUnknownMark(name string) ErrUnknownMark {
+	return ErrUnknownMark{
+		error: fmt.Errorf("unknown bookmark: %q", name),
+		Name:  name,
+	}
+}
+
+type ErrInvalidBCD struct {
+	error
+	Offset int
+	Byte   byte
+}
+
+func NewErrInvalidBCD(offset int, b byte) ErrInvalidBCD {
+	return ErrInvalidBCD{
+		error:  fmt.Errorf("invalid BCD byte %#02x at offset %d", b, offset),
+		Offset: offset,
+		Byte:   b,
+	}
+}
+
+type ErrInvalidDigitCount struct {
+	error
+	Digits int
+	Max    int
+}
+
+func NewErrInvalidDigitCount(digits, maxDigits int) ErrInvalidDigitCount {
+	return ErrInvalidDigitCount{
+		error:  fmt.Errorf("invalid digit count: %d, expected 0 to %d", digits, maxDigits),
+		Digits: digits,
+		Max:    maxDigits,
+	}
+}
+
+type ErrBCDOverflow struct {
+	error
+	Value  uint64
+	Digits int
+}
+
+func NewErrBCDOverflow(value uint64, digits int) ErrBCDOverflow {
+	return ErrBCDOverflow{
+		error:  fmt.Errorf("BCD overflow: %d does not fit in %d digits", value, digits),
+		Value:  value,
+		Digits: digits,
+	}
+}
+
+type ErrLengthOverflow struct {
+	error
+	Length int
+	Size   int
+}
+
+func NewErrLengthOverflow(length, size int) ErrLengthOverflow {
+	return ErrLengthOverflow{
+		error:  fmt.Errorf("length %d does not fit in a %d-byte prefix", length, size),
+		Length: length,
+		Size:   size,
+	}
+}
+
+type ErrOffsetIndex struct {
+	error
+	Index  int
+	Offset int
+	Err    error
+}
+
+func NewErrOffsetIndex(index, offset int, err error) ErrOffsetIndex {
+	return ErrOffsetIndex{
+		error:  fmt.Errorf("offsets[%d] = %d: %w", index, offset, err),
+		Index:  index,
+		Offset: offset,
+		Err:    err,
+	}
+}
+
+func (e ErrOffsetIndex) Unwrap() error {
+	return e.Err
+}
+
+type ErrRLEExpansionLimit struct {
+	error
+	Length int
+	Limit  int
+}
+
+func NewErrRLEExpansionLimit(length, limit int) ErrRLEExpansionLimit {
+	return ErrRLEExpansionLimit{
+		error:  fmt.Errorf("RLE expansion limit exceeded: %d bytes, limit %d", length, limit),
+		Length: length,
+		Limit:  limit,
+	}
+}
+
+type ErrReservedNonZero struct {
+	error
+	Offset int
+	Value  byte
+}
+
+func NewErrReservedNonZero(offset int, value byte) ErrReservedNonZero {
+	return ErrReservedNonZero{
+		error:  fmt.Errorf("reserved byte at offset %d is non-zero: %#02x", offset, value),
+		Offset: offset,
+		Value:  value,
+	}
+}
+
+type ErrInvalidMatrixLayout struct {
+	error
+	Layout MatrixLayout
+}
+
+func NewErrInvalidMatrixLayout(layout MatrixLayout) ErrInvalidMatrixLayout {
+	return ErrInvalidMatrixLayout{
+		error:  fmt.Errorf("invalid matrix layout: %d, expected RowMajor or ColMajor", layout),
+		Layout: layout,
+	}
+}
@@ -0,0 +1,106 @@
+package buffergenerics
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"github.com/stretchr/testify/assert"
+	"reflect"
+	"testing"
+)
+
+func TestErrUnknownKind(t *testing.T) {
+	t.Run("it should enumerate the supported kinds", func(t *testing.T) {
+		err := NewErrUnknownKind(reflect.Complex64)
+
+		assert.EqualError(t, err, "unknown kind: complex64, expected one of "+
+			"[int int8 int16 int32 int64 uint uint8 uint16 uint32 uint64 uintptr float32 float64]")
+	})
+
+	t.Run("it should expose the kind through errors.As", func(t *testing.T) {
+		err := fmt.Errorf("decoding: %w", NewErrUnknownKind(reflect.Bool))
+
+		var errKind ErrUnknownKind
+		assert.True(t, errors.As(err, &errKind))
+		assert.Equal(t, reflect.Bool, errKind.Kind)
+	})
+}
+
+func TestErrChecksumMismatch(t *testing.T) {
+	t.Run("it should format the expected and actual checksums", func(t *testing.T) {
+		err := NewErrChecksumMismatch(0x3f, 0x40)
+
+		assert.EqualError(t, err, "checksum mismatch: expected 0x3f, got 0x40")
+	})
+
+	t.Run("it should pad single-digit checksums", func(t *testing.T) {
+		err := NewErrChecksumMismatch(0x00, 0x0a)
+
+		assert.EqualError(t, err, "checksum mismatch: expected 0x00, got 0x0a")
+	})
+
+	t.Run("it should expose its fields through errors.As", func(t *testing.T) {
+		err := fmt.Errorf("reading frame: %w", NewErrChecksumMismatch(0x3f, 0x40))
+
+		var errMismatch ErrChecksumMismatch
+		assert.True(t, errors.As(err, &errMismatch))
+		assert.Equal(t, byte(0x3f), errMismatch.Expected)
+		assert.Equal(t, byte(0x40), errMismatch.Actual)
+	})
+}
+
+func TestErrBadMagic(t *testing.T) {
+	t.Run("it should format the expected and got values", func(t *testing.T) {
+		err := NewErrBadMagic(0x89504e47, 0x25504446)
+
+		assert.EqualError(t, err, "bad magic: expected 0x89504e47, got 0x25504446")
+	})
+
+	t.Run("it should pad small values to eight digits", func(t *testing.T) {
+		err := NewErrBadMagic(0x1, 0xcafe)
+
+		assert.EqualError(t, err, "bad magic: expected 0x00000001, got 0x0000cafe")
+	})
+
+	t.Run("it should expose its fields through errors.As", func(t *testing.T) {
+		err := fmt.Errorf("reading header: %w", NewErrBadMagic(0x89504e47, 0x25504446))
+
+		var errMagic ErrBadMagic
+		assert.True(t, errors.As(err, &errMagic))
+		assert.Equal(t, uint32(0x89504e47), errMagic.Expected)
+		assert.Equal(t, uint32(0x25504446), errMagic.Got)
+	})
+}
+
+func TestErrInvalidEnum(t *testing.T) {
+	t.Run("it should format the value and the valid set", func(t *testing.T) {
+		err := NewErrInvalidEnum("7", "[0 1 2]")
+
+		assert.EqualError(t, err, "invalid enum value 7, expected one of [0 1 2]")
+	})
+
+	t.Run("it should format negative values", func(t *testing.T) {
+		err := NewErrInvalidEnum("-1", "[0 1]")
+
+		assert.EqualError(t, err, "invalid enum value -1, expected one of [0 1]")
+	})
+
+	t.Run("it should expose its fields through errors.As", func(t *testing.T) {
+		err := fmt.Errorf("reading color: %w", NewErrInvalidEnum("7", "[0 1 2]"))
+
+		var errEnum ErrInvalidEnum
+		assert.True(t, errors.As(err, &errEnum))
+		assert.Equal(t, "7", errEnum.Value)
+		assert.Equal(t, "[0 1 2]", errEnum.Valid)
+	})
+}
+
+func TestErrOrderSliceLength(t *testing.T) {
+	t.Run("it should wrap the underlying error", func(t *testing.T) {
+		cause := errors.New("index out of range")
+		err := NewErrOrderSliceLength(binary.BigEndian, 4, cause)
+
+		assert.ErrorIs(t, err, cause)
+		assert.EqualError(t, err, "byte order BigEndian failed to decode a 4-byte value: index out of range")
+	})
+}
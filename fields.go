@@ -0,0 +1,27 @@
+package buffergenerics
+
+import (
+	"encoding/binary"
+	"maps"
+	"slices"
+)
+
+// ReadFieldsOrderedT reads a value of type T at each of the named offsets in the given buffer, using the specified
+// byte order. If the byte order is nil, it defaults to binary.NativeEndian. It returns a map from each field name
+// to its value. Fields are read in name order and the first failure is returned as ErrField, which names the field
+// and wraps the underlying error (e.g. io.EOF for an out-of-range offset).
+// See also: ReadOrderedT.
+func ReadFieldsOrderedT[T Numeric](buffer []byte, offsets map[string]int, order binary.ByteOrder) (map[string]T, error) {
+	values := make(map[string]T, len(offsets))
+
+	for _, name := range slices.Sorted(maps.Keys(offsets)) {
+		val, err := ReadOrderedT[T](buffer, offsets[name], order)
+		if err != nil {
+			return nil, NewErrField(name, err)
+		}
+
+		values[name] = val
+	}
+
+	return values, nil
+}
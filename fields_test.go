@@ -0,0 +1,39 @@
+package buffergenerics
+
+import (
+	"encoding/binary"
+	"github.com/stretchr/testify/assert"
+	"io"
+	"testing"
+)
+
+func TestReadFieldsOrderedT(t *testing.T) {
+	t.Run("it should read each named offset", func(t *testing.T) {
+		buf := []byte{0x00, 0x01, 0xFF, 0x00, 0x02}
+
+		values, err := ReadFieldsOrderedT[uint16](buf, map[string]int{"width": 0, "height": 3}, binary.BigEndian)
+
+		assert.NoError(t, err, "it should not return an error")
+		assert.Equal(t, map[string]uint16{"width": 1, "height": 2}, values)
+	})
+
+	t.Run("it should return an empty map for no offsets", func(t *testing.T) {
+		values, err := ReadFieldsOrderedT[uint16](nil, nil, binary.BigEndian)
+
+		assert.NoError(t, err, "it should not return an error")
+		assert.Empty(t, values)
+	})
+
+	t.Run("it should name the field with an out-of-range offset", func(t *testing.T) {
+		buf := []byte{0x00, 0x01, 0xFF, 0x00, 0x02}
+
+		values, err := ReadFieldsOrderedT[uint16](buf, map[string]int{"width": 0, "depth": 4}, binary.BigEndian)
+
+		var errField ErrField
+		assert.ErrorAs(t, err, &errField)
+		assert.Equal(t, "depth", errField.Field)
+		assert.ErrorIs(t, err, io.EOF)
+		assert.EqualError(t, err, `field "depth": EOF`)
+		assert.Nil(t, values)
+	})
+}
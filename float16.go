@@ -0,0 +1,126 @@
+package buffergenerics
+
+import (
+	"encoding/binary"
+	"math"
+)
+
+// ReadFloat16Ordered reads an IEEE 754 half-precision float from the given buffer starting at the specified offset,
+// using the specified byte order. If the byte order is nil, it defaults to binary.NativeEndian.
+// It returns the value widened to float32, which represents every half-precision value exactly, including
+// subnormals, infinities, and NaNs, and any error encountered during the read operation.
+// See also: AppendFloat16.
+func ReadFloat16Ordered(buffer []byte, offset int, order binary.ByteOrder) (float32, error) {
+	h, err := ReadOrderedT[uint16](buffer, offset, order)
+	if err != nil {
+		return 0, err
+	}
+
+	return float16ToFloat32(h), nil
+}
+
+// AppendFloat16 appends the IEEE 754 half-precision encoding of value to the given buffer, using the specified
+// byte order. If the byte order is nil, it defaults to binary.NativeEndian. The value is rounded to the nearest
+// half-precision value, ties to even; values beyond the half-precision range become infinities, and NaNs remain NaNs.
+// It returns the extended buffer and any error encountered during the write operation.
+// See also: ReadFloat16Ordered.
+func AppendFloat16(buffer []byte, value float32, order binary.ByteOrder) ([]byte, error) {
+	return AppendOrderedT[uint16](buffer, float32ToFloat16(value), order)
+}
+
+// float16ToFloat32 decodes the half-precision bit pattern h.
+func float16ToFloat32(h uint16) float32 {
+	sign := uint32(h&0x8000) << 16
+	exp := uint32(h>>10) & 0x1f
+	mant := uint32(h & 0x3ff)
+
+	switch exp {
+	case 0:
+		// Zero or subnormal: mant * 2^-24, which is exact in float32.
+		f := float32(mant) * (1.0 / (1 << 24))
+		return math.Float32frombits(sign | math.Float32bits(f))
+	case 0x1f:
+		// Infinity or NaN, preserving the NaN payload.
+		return math.Float32frombits(sign | 0x7f800000 | mant<<13)
+	}
+
+	return math.Float32frombits(sign | (exp+127-15)<<23 | mant<<13)
+}
+
+// float32ToFloat16 encodes f as a half-precision bit pattern, rounding to nearest, ties to even.
+func float32ToFloat16(f float32) uint16 {
+	bits := math.Float32bits(f)
+	sign := uint16(bits>>16) & 0x8000
+	exp := int(bits>>23) & 0xff
+	mant := bits & 0x7fffff
+
+	if exp == 0xff {
+		if mant != 0 {
+			// Keep NaNs quiet and preserve the high bits of the payload.
+			return sign | 0x7e00 | uint16(mant>>13)
+		}
+
+		return sign | 0x7c00
+	}
+
+	e := exp - 127 + 15
+	if e >= 0x1f {
+		return sign | 0x7c00
+	}
+
+	if e <= 0 {
+		// Subnormal or zero: the implicit leading bit becomes explicit, and values too small to round up vanish.
+		if e < -10 {
+			return sign
+		}
+
+		mant |= 0x800000
+		shift := uint(14 - e)
+		half := uint16(mant >> shift)
+		rem, halfway := mant&(1<<shift-1), uint32(1)<<(shift-1)
+		if rem > halfway || (rem == halfway && half&1 == 1) {
+			half++
+		}
+
+		return sign | half
+	}
+
+	// Rounding may carry into the exponent, which correctly yields the next power of two or infinity.
+	half := uint16(e)<<10 | uint16(mant>>13)
+	rem := mant & 0x1fff
+	if rem > 0x1000 || (rem == 0x1000 && half&1 == 1) {
+		half++
+	}
+
+	return sign | half
+}
+
+// ReadBFloat16Ordered reads a bfloat16 from the given buffer starting at the specified offset, using the specified
+// byte order. If the byte order is nil, it defaults to binary.NativeEndian. A bfloat16 is the high 16 bits of a
+// float32, so it is widened exactly by zeroing the low 16 bits of the mantissa.
+// It returns the value as a float32 and any error encountered during the read operation.
+// See also: AppendBFloat16.
+func ReadBFloat16Ordered(buffer []byte, offset int, order binary.ByteOrder) (float32, error) {
+	b, err := ReadOrderedT[uint16](buffer, offset, order)
+	if err != nil {
+		return 0, err
+	}
+
+	return math.Float32frombits(uint32(b) << 16), nil
+}
+
+// AppendBFloat16 appends the bfloat16 encoding of value to the given buffer, using the specified byte order.
+// If the byte order is nil, it defaults to binary.NativeEndian. The low 16 bits of value are truncated, which
+// rounds toward zero and loses all but 8 bits of precision; NaNs whose payload lies only in the truncated bits
+// are kept NaN. It returns the extended buffer and any error encountered during the write operation.
+// See also: ReadBFloat16Ordered.
+func AppendBFloat16(buffer []byte, value float32, order binary.ByteOrder) ([]byte, error) {
+	bits := math.Float32bits(value)
+	b := uint16(bits >> 16)
+
+	if math.IsNaN(float64(value)) && b&0x7f == 0 {
+		b |= 0x40
+	}
+
+	return AppendOrderedT[uint16](buffer, b, order)
+}
@@ -0,0 +1,179 @@
+package buffergenerics
+
+import (
+	"encoding/binary"
+	"github.com/stretchr/testify/assert"
+	"io"
+	"math"
+	"testing"
+)
+
+var float16Cases = []struct {
+	name string
+	bits uint16
+	want float32
+}{
+	{"zero", 0x0000, 0},
+	{"one", 0x3c00, 1},
+	{"negative two", 0xc000, -2},
+	{"largest normal", 0x7bff, 65504},
+	{"smallest normal", 0x0400, 1.0 / (1 << 14)},
+	{"smallest subnormal", 0x0001, 1.0 / (1 << 24)},
+	{"largest subnormal", 0x03ff, 1023.0 / (1 << 24)},
+	{"positive infinity", 0x7c00, float32(math.Inf(1))},
+	{"negative infinity", 0xfc00, float32(math.Inf(-1))},
+}
+
+func TestReadFloat16Ordered(t *testing.T) {
+	t.Run("it should decode known half-precision bit patterns", func(t *testing.T) {
+		for _, tc := range float16Cases {
+			buf := binary.BigEndian.AppendUint16(nil, tc.bits)
+
+			got, err := ReadFloat16Ordered(buf, 0, binary.BigEndian)
+
+			assert.NoError(t, err, "it should not return an error")
+			assert.Equal(t, tc.want, got, tc.name)
+		}
+	})
+
+	t.Run("it should decode negative zero", func(t *testing.T) {
+		got, err := ReadFloat16Ordered([]byte{0x00, 0x80}, 0, binary.LittleEndian)
+
+		assert.NoError(t, err, "it should not return an error")
+		assert.True(t, math.Signbit(float64(got)))
+		assert.Zero(t, got)
+	})
+
+	t.Run("it should decode NaN", func(t *testing.T) {
+		got, err := ReadFloat16Ordered([]byte{0x7e, 0x00}, 0, binary.BigEndian)
+
+		assert.NoError(t, err, "it should not return an error")
+		assert.True(t, math.IsNaN(float64(got)))
+	})
+
+	t.Run("it should return an EOF error for short buffers", func(t *testing.T) {
+		_, err := ReadFloat16Ordered([]byte{0x3c}, 0, binary.BigEndian)
+
+		assert.ErrorIs(t, err, io.EOF)
+	})
+}
+
+func TestAppendFloat16(t *testing.T) {
+	t.Run("it should encode known half-precision bit patterns", func(t *testing.T) {
+		for _, tc := range float16Cases {
+			buf, err := AppendFloat16(nil, tc.want, binary.BigEndian)
+
+			assert.NoError(t, err, "it should not return an error")
+			assert.Equal(t, tc.bits, binary.BigEndian.Uint16(buf), tc.name)
+		}
+	})
+
+	t.Run("it should round to nearest, ties to even", func(t *testing.T) {
+		for _, tc := range []struct {
+			value float32
+			bits  uint16
+		}{
+			{1 + 1.0/(1<<11), 0x3c00},               // halfway, rounds down to even
+			{1 + 3.0/(1<<11), 0x3c02},               // halfway, rounds up to even
+			{1 + 1.0/(1<<11) + 1.0/(1<<20), 0x3c01}, // above halfway
+			{1.0 / (1 << 25), 0x0000},               // halfway to the smallest subnormal
+			{1.0/(1<<25) + 1.0/(1<<30), 0x0001},     // above halfway to the smallest subnormal
+			{65520, 0x7c00},                         // rounds up past the largest normal
+			{float32(math.Inf(-1)), 0xfc00},         // infinity is preserved
+			{1e-30, 0x0000},                         // underflows to zero
+		} {
+			buf, err := AppendFloat16(nil, tc.value, binary.BigEndian)
+
+			assert.NoError(t, err, "it should not return an error")
+			assert.Equal(t, tc.bits, binary.BigEndian.Uint16(buf), "%g", tc.value)
+		}
+	})
+
+	t.Run("it should keep NaN a NaN", func(t *testing.T) {
+		buf, err := AppendFloat16([]byte{0xAA}, float32(math.NaN()), binary.LittleEndian)
+		assert.NoError(t, err, "it should not return an error")
+
+		got, err := ReadFloat16Ordered(buf, 1, binary.LittleEndian)
+
+		assert.NoError(t, err, "it should not return an error")
+		assert.True(t, math.IsNaN(float64(got)))
+		assert.Equal(t, byte(0xAA), buf[0])
+	})
+}
+
+func TestReadBFloat16Ordered(t *testing.T) {
+	t.Run("it should place the bits in the high half of a float32", func(t *testing.T) {
+		got, err := ReadBFloat16Ordered([]byte{0x3f, 0x80}, 0, binary.BigEndian)
+
+		assert.NoError(t, err, "it should not return an error")
+		assert.Equal(t, float32(1), got)
+	})
+
+	t.Run("it should decode special values", func(t *testing.T) {
+		for bits, check := range map[uint16]func(float32) bool{
+			0x0000: func(f float32) bool { return f == 0 && !math.Signbit(float64(f)) },
+			0x8000: func(f float32) bool { return f == 0 && math.Signbit(float64(f)) },
+			0x7f80: func(f float32) bool { return math.IsInf(float64(f), 1) },
+			0xff80: func(f float32) bool { return math.IsInf(float64(f), -1) },
+			0x7fc0: func(f float32) bool { return math.IsNaN(float64(f)) },
+		} {
+			got, err := ReadBFloat16Ordered(binary.LittleEndian.AppendUint16(nil, bits), 0, binary.LittleEndian)
+
+			assert.NoError(t, err, "it should not return an error")
+			assert.True(t, check(got), "%#04x decoded as %g", bits, got)
+		}
+	})
+
+	t.Run("it should return an EOF error for short buffers", func(t *testing.T) {
+		_, err := ReadBFloat16Ordered([]byte{0x3f}, 0, binary.BigEndian)
+
+		assert.ErrorIs(t, err, io.EOF)
+	})
+}
+
+func TestAppendBFloat16(t *testing.T) {
+	t.Run("it should round-trip values representable in 8 bits of precision exactly", func(t *testing.T) {
+		for _, want := range []float32{0, 1, -2, 0.5, 3.140625, 1 << 100} {
+			buf, err := AppendBFloat16(nil, want, binary.BigEndian)
+			assert.NoError(t, err, "it should not return an error")
+
+			got, err := ReadBFloat16Ordered(buf, 0, binary.BigEndian)
+
+			assert.NoError(t, err, "it should not return an error")
+			assert.Equal(t, want, got)
+		}
+	})
+
+	t.Run("it should truncate toward zero, losing precision", func(t *testing.T) {
+		for _, tc := range []struct{ value, want float32 }{
+			{3.14159265, 3.140625},
+			{-3.14159265, -3.140625},
+			{1.00390625 - 1.0/(1<<20), 1},
+		} {
+			buf, err := AppendBFloat16(nil, tc.value, binary.LittleEndian)
+			assert.NoError(t, err, "it should not return an error")
+
+			got, err := ReadBFloat16Ordered(buf, 0, binary.LittleEndian)
+
+			assert.NoError(t, err, "it should not return an error")
+			assert.Equal(t, tc.want, got)
+		}
+	})
+
+	t.Run("it should preserve special values", func(t *testing.T) {
+		nan := math.Float32frombits(0x7f800001) // a NaN whose payload is entirely truncated
+
+		for _, value := range []float32{float32(math.Inf(1)), float32(math.Inf(-1)), nan} {
+			buf, err := AppendBFloat16(nil, value, binary.BigEndian)
+			assert.NoError(t, err, "it should not return an error")
+
+			got, _ := ReadBFloat16Ordered(buf, 0, binary.BigEndian)
+
+			if math.IsNaN(float64(value)) {
+				assert.True(t, math.IsNaN(float64(got)))
+			} else {
+				assert.Equal(t, value, got)
+			}
+		}
+	})
+}
@@ -0,0 +1,55 @@
+package buffergenerics
+
+import (
+	"bufio"
+	"encoding/binary"
+	"errors"
+	"io"
+)
+
+// SplitFrames splits the given buffer into consecutive length-prefixed frames, using the specified byte order for
+// each prefix of lengthSize bytes (1, 2, 4, or 8). If the byte order is nil, it defaults to binary.NativeEndian.
+// It returns the payload of each complete frame, as views into the buffer, and the number of bytes consumed by them.
+// Splitting stops without error at the first incomplete frame, which is left unconsumed so that it can be retried
+// once more data arrives. It returns ErrInvalidLengthSize for an unsupported lengthSize.
+func SplitFrames(buffer []byte, lengthSize int, order binary.ByteOrder) (frames [][]byte, consumed int, err error) {
+	for consumed < len(buffer) {
+		start, end, err := readLengthPrefix(buffer, consumed, lengthSize, order)
+		if errors.Is(err, io.EOF) {
+			break
+		} else if err != nil {
+			return nil, 0, err
+		}
+
+		frames = append(frames, buffer[start:end:end])
+		consumed = end
+	}
+
+	return frames, consumed, nil
+}
+
+// LengthPrefixSplitFunc returns a bufio.SplitFunc for use with bufio.Scanner.Split that tokenizes a stream into
+// the payloads of length-prefixed frames, using the specified byte order for each prefix of lengthSize bytes
+// (1, 2, 4, or 8). If the byte order is nil, it defaults to binary.NativeEndian. A stream ending partway through
+// a frame results in io.ErrUnexpectedEOF.
+// See also: SplitFrames.
+func LengthPrefixSplitFunc(lengthSize int, order binary.ByteOrder) bufio.SplitFunc {
+	return func(data []byte, atEOF bool) (advance int, token []byte, err error) {
+		if atEOF && len(data) == 0 {
+			return 0, nil, nil
+		}
+
+		start, end, err := readLengthPrefix(data, 0, lengthSize, order)
+		if errors.Is(err, io.EOF) {
+			if atEOF {
+				return 0, nil, io.ErrUnexpectedEOF
+			}
+
+			return 0, nil, nil
+		} else if err != nil {
+			return 0, nil, err
+		}
+
+		return end, data[start:end], nil
+	}
+}
@@ -0,0 +1,109 @@
+package buffergenerics
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"github.com/stretchr/testify/assert"
+	"io"
+	"testing"
+	"testing/iotest"
+)
+
+func TestSplitFrames(t *testing.T) {
+	t.Run("it should split complete frames and leave a trailing partial frame", func(t *testing.T) {
+		order := binary.BigEndian
+		buf := appendString(t, nil, "hello", 2, order)
+		buf = appendString(t, buf, "", 2, order)
+		buf = appendString(t, buf, "world", 2, order)
+		complete := len(buf)
+		buf = appendString(t, buf, "partial", 2, order)[:complete+4]
+
+		frames, consumed, err := SplitFrames(buf, 2, order)
+
+		assert.NoError(t, err, "it should not return an error")
+		assert.Equal(t, [][]byte{[]byte("hello"), {}, []byte("world")}, frames)
+		assert.Equal(t, complete, consumed)
+	})
+
+	t.Run("it should stop at a truncated length prefix", func(t *testing.T) {
+		order := binary.LittleEndian
+		buf := appendString(t, nil, "abc", 4, order)
+		buf = append(buf, 0x01, 0x00)
+
+		frames, consumed, err := SplitFrames(buf, 4, order)
+
+		assert.NoError(t, err, "it should not return an error")
+		assert.Equal(t, [][]byte{[]byte("abc")}, frames)
+		assert.Equal(t, 7, consumed)
+	})
+
+	t.Run("it should return no frames for an empty buffer", func(t *testing.T) {
+		frames, consumed, err := SplitFrames(nil, 1, nil)
+
+		assert.NoError(t, err, "it should not return an error")
+		assert.Empty(t, frames)
+		assert.Zero(t, consumed)
+	})
+
+	t.Run("it should return ErrInvalidLengthSize for unsupported prefix sizes", func(t *testing.T) {
+		_, _, err := SplitFrames([]byte{0x00, 0x00, 0x00}, 3, nil)
+
+		assert.ErrorAs(t, err, &ErrInvalidLengthSize{})
+	})
+}
+
+func TestLengthPrefixSplitFunc(t *testing.T) {
+	t.Run("it should tokenize a stream of frames", func(t *testing.T) {
+		order := binary.BigEndian
+		want := []string{"hello", "", "length-prefixed", "world"}
+		var buf []byte
+		for _, s := range want {
+			buf = appendString(t, buf, s, 2, order)
+		}
+
+		scanner := bufio.NewScanner(bytes.NewReader(buf))
+		scanner.Split(LengthPrefixSplitFunc(2, order))
+
+		var got []string
+		for scanner.Scan() {
+			got = append(got, string(scanner.Bytes()))
+		}
+
+		assert.NoError(t, scanner.Err(), "it should not return an error")
+		assert.Equal(t, want, got)
+	})
+
+	t.Run("it should handle frames split across reads", func(t *testing.T) {
+		order := binary.LittleEndian
+		buf := appendString(t, nil, "first", 4, order)
+		buf = appendString(t, buf, "second", 4, order)
+
+		scanner := bufio.NewScanner(iotest.OneByteReader(bytes.NewReader(buf)))
+		scanner.Split(LengthPrefixSplitFunc(4, order))
+
+		var got []string
+		for scanner.Scan() {
+			got = append(got, scanner.Text())
+		}
+
+		assert.NoError(t, scanner.Err(), "it should not return an error")
+		assert.Equal(t, []string{"first", "second"}, got)
+	})
+
+	t.Run("it should report an unexpected EOF for a trailing partial frame", func(t *testing.T) {
+		buf := appendString(t, nil, "complete", 1, nil)
+		buf = appendString(t, buf, "partial", 1, nil)
+
+		scanner := bufio.NewScanner(bytes.NewReader(buf[:len(buf)-1]))
+		scanner.Split(LengthPrefixSplitFunc(1, nil))
+
+		var got []string
+		for scanner.Scan() {
+			got = append(got, scanner.Text())
+		}
+
+		assert.ErrorIs(t, scanner.Err(), io.ErrUnexpectedEOF)
+		assert.Equal(t, []string{"complete"}, got)
+	})
+}
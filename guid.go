@@ -0,0 +1,48 @@
+package buffergenerics
+
+import (
+	"encoding/binary"
+	"encoding/hex"
+)
+
+// ReadGUID reads a 16-byte Microsoft GUID from the given buffer starting at the specified offset.
+// A GUID stores its first three fields, of 4, 2, and 2 bytes, little-endian and the remaining 8 bytes as-is;
+// ReadGUID reorders them so that the result is in the canonical big-endian byte order of RFC 9562 UUIDs.
+// It returns io.EOF if the buffer is too short.
+// See also: ReadGUIDString.
+func ReadGUID(buffer []byte, offset int) ([16]byte, error) {
+	var raw, guid [16]byte
+	if err := ReadFixedBytesInto(buffer, offset, raw[:]); err != nil {
+		return guid, err
+	}
+
+	binary.BigEndian.PutUint32(guid[0:4], binary.LittleEndian.Uint32(raw[0:4]))
+	binary.BigEndian.PutUint16(guid[4:6], binary.LittleEndian.Uint16(raw[4:6]))
+	binary.BigEndian.PutUint16(guid[6:8], binary.LittleEndian.Uint16(raw[6:8]))
+	copy(guid[8:], raw[8:])
+
+	return guid, nil
+}
+
+// ReadGUIDString reads a 16-byte Microsoft GUID from the given buffer starting at the specified offset, as with
+// ReadGUID, and returns it in the canonical lowercase form xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx.
+// It returns io.EOF if the buffer is too short.
+func ReadGUIDString(buffer []byte, offset int) (string, error) {
+	guid, err := ReadGUID(buffer, offset)
+	if err != nil {
+		return "", err
+	}
+
+	var s [36]byte
+	hex.Encode(s[0:8], guid[0:4])
+	s[8] = '-'
+	hex.Encode(s[9:13], guid[4:6])
+	s[13] = '-'
+	hex.Encode(s[14:18], guid[6:8])
+	s[18] = '-'
+	hex.Encode(s[19:23], guid[8:10])
+	s[23] = '-'
+	hex.Encode(s[24:36], guid[10:16])
+
+	return string(s[:]), nil
+}
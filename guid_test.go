@@ -0,0 +1,61 @@
+package buffergenerics
+
+import (
+	"github.com/stretchr/testify/assert"
+	"io"
+	"testing"
+)
+
+// testGUIDBytes is {00112233-4455-6677-8899-aabbccddeeff} as laid out in memory.
+var testGUIDBytes = []byte{
+	0x33, 0x22, 0x11, 0x00, 0x55, 0x44, 0x77, 0x66,
+	0x88, 0x99, 0xAA, 0xBB, 0xCC, 0xDD, 0xEE, 0xFF,
+}
+
+func TestReadGUID(t *testing.T) {
+	t.Run("it should reorder the mixed-endian fields", func(t *testing.T) {
+		buf := append([]byte{0xFF}, testGUIDBytes...)
+
+		got, err := ReadGUID(buf, 1)
+
+		assert.NoError(t, err, "it should not return an error")
+		assert.Equal(t, [16]byte{
+			0x00, 0x11, 0x22, 0x33, 0x44, 0x55, 0x66, 0x77,
+			0x88, 0x99, 0xAA, 0xBB, 0xCC, 0xDD, 0xEE, 0xFF,
+		}, got)
+	})
+
+	t.Run("it should return an EOF error for short buffers", func(t *testing.T) {
+		_, err := ReadGUID(testGUIDBytes[:15], 0)
+
+		assert.ErrorIs(t, err, io.EOF)
+	})
+}
+
+func TestReadGUIDString(t *testing.T) {
+	t.Run("it should format the GUID canonically", func(t *testing.T) {
+		got, err := ReadGUIDString(testGUIDBytes, 0)
+
+		assert.NoError(t, err, "it should not return an error")
+		assert.Equal(t, "00112233-4455-6677-8899-aabbccddeeff", got)
+	})
+
+	t.Run("it should format a well-known GUID", func(t *testing.T) {
+		// IID_IUnknown, {00000000-0000-0000-C000-000000000046}.
+		buf := []byte{
+			0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+			0xC0, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x46,
+		}
+
+		got, err := ReadGUIDString(buf, 0)
+
+		assert.NoError(t, err, "it should not return an error")
+		assert.Equal(t, "00000000-0000-0000-c000-000000000046", got)
+	})
+
+	t.Run("it should return an EOF error for short buffers", func(t *testing.T) {
+		_, err := ReadGUIDString(testGUIDBytes, 1)
+
+		assert.ErrorIs(t, err, io.EOF)
+	})
+}
@@ -0,0 +1,28 @@
+package buffergenerics
+
+import (
+	"encoding/binary"
+)
+
+// ReadHeader reads a common file preamble from the given buffer starting at the specified offset, using the
+// specified byte order: a uint32 magic value followed by a uint16 version. If the byte order is nil, it defaults
+// to binary.NativeEndian. It returns the version and the number of bytes consumed, or ErrBadMagic if the magic
+// value does not match expectMagic and io.EOF if the header is truncated. The magic value is checked before
+// the version is read, so a truncated header with the wrong magic still reports ErrBadMagic.
+func ReadHeader(buffer []byte, offset int, expectMagic uint32, order binary.ByteOrder) (version uint16, bytesRead int, err error) {
+	magic, err := ReadOrderedT[uint32](buffer, offset, order)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	if magic != expectMagic {
+		return 0, 0, NewErrBadMagic(expectMagic, magic)
+	}
+
+	version, err = ReadOrderedT[uint16](buffer, offset+4, order)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	return version, 6, nil
+}
@@ -0,0 +1,57 @@
+package buffergenerics
+
+import (
+	"encoding/binary"
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/stretchr/testify/assert"
+	"io"
+	"testing"
+)
+
+func TestReadHeader(t *testing.T) {
+	const magic uint32 = 0x89504E47
+
+	t.Run("it should return the version for a good magic", func(t *testing.T) {
+		order := binary.BigEndian
+		want := gofakeit.Uint16()
+		buf := []byte{0xAA}
+		buf, _ = AppendOrderedT(buf, magic, order)
+		buf, _ = AppendOrderedT(buf, want, order)
+
+		version, n, err := ReadHeader(buf, 1, magic, order)
+
+		assert.NoError(t, err, "it should not return an error")
+		assert.Equal(t, want, version)
+		assert.Equal(t, 6, n)
+	})
+
+	t.Run("it should return ErrBadMagic for a bad magic", func(t *testing.T) {
+		order := binary.BigEndian
+		buf, _ := AppendOrderedT[uint32](nil, 0x25504446, order)
+		buf, _ = AppendOrderedT[uint16](buf, 1, order)
+
+		version, n, err := ReadHeader(buf, 0, magic, order)
+
+		var errMagic ErrBadMagic
+		assert.ErrorAs(t, err, &errMagic)
+		assert.Equal(t, magic, errMagic.Expected)
+		assert.Equal(t, uint32(0x25504446), errMagic.Got)
+		assert.Zero(t, version)
+		assert.Zero(t, n)
+	})
+
+	t.Run("it should return an EOF error for a truncated magic", func(t *testing.T) {
+		_, _, err := ReadHeader([]byte{0x89, 0x50}, 0, magic, binary.BigEndian)
+
+		assert.ErrorIs(t, err, io.EOF)
+	})
+
+	t.Run("it should return an EOF error for a truncated version", func(t *testing.T) {
+		buf, _ := AppendOrderedT(nil, magic, binary.LittleEndian)
+		buf = append(buf, 0x01)
+
+		_, _, err := ReadHeader(buf, 0, magic, binary.LittleEndian)
+
+		assert.ErrorIs(t, err, io.EOF)
+	})
+}
@@ -0,0 +1,61 @@
+package buffergenerics
+
+import (
+	"encoding/binary"
+	"io"
+	"iter"
+)
+
+// ForEachOrderedT reads count consecutive values of type T from the given buffer starting at the specified offset,
+// using the specified byte order, and invokes fn with the index and value of each in turn. If the byte order is nil,
+// it defaults to binary.NativeEndian. Iteration stops early if fn returns a non-nil error, which is returned as-is.
+// It returns the number of bytes consumed, including the element passed to an aborting fn, and io.EOF without
+// invoking fn if the buffer cannot hold count elements, or ErrCountOverflow if count is too large to be
+// represented in bytes. No intermediate slice is allocated.
+func ForEachOrderedT[T Numeric](buffer []byte, offset, count int, order binary.ByteOrder, fn func(i int, v T) error) (int, error) {
+	size := SizeOf[T]()
+
+	if offset < 0 || count < 0 {
+		return 0, io.EOF
+	}
+
+	total, err := extentOf(count, size, size)
+	if err != nil {
+		return 0, err
+	}
+
+	if total > len(buffer)-offset {
+		return 0, io.EOF
+	}
+
+	for i := 0; i < count; i++ {
+		val, err := ReadOrderedT[T](buffer, offset+i*size, order)
+		if err != nil {
+			return i * size, err
+		}
+
+		if err := fn(i, val); err != nil {
+			return (i + 1) * size, err
+		}
+	}
+
+	return count * size, nil
+}
+
+// IterOrderedT returns an iterator over count consecutive values of type T in the given buffer starting at the
+// specified offset, using the specified byte order. If the byte order is nil, it defaults to binary.NativeEndian.
+// Each value is decoded lazily as the iterator advances. If the buffer runs out before count values have been
+// yielded, a zero value paired with io.EOF is yielded as the final element.
+// See also: ForEachOrderedT.
+func IterOrderedT[T Numeric](buffer []byte, offset, count int, order binary.ByteOrder) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		size := SizeOf[T]()
+
+		for i := 0; i < count; i++ {
+			val, err := ReadOrderedT[T](buffer, offset+i*size, order)
+			if !yield(val, err) || err != nil {
+				return
+			}
+		}
+	}
+}
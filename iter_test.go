@@ -0,0 +1,127 @@
+package buffergenerics
+
+import (
+	"encoding/binary"
+	"errors"
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/stretchr/testify/assert"
+	"io"
+	"math"
+	"testing"
+)
+
+func TestForEachOrderedT(t *testing.T) {
+	t.Run("it should visit each packed value in order", func(t *testing.T) {
+		order := binary.LittleEndian
+		var buf []byte
+		var want uint64
+		for i := 0; i < 10; i++ {
+			v := uint32(gofakeit.Uint16())
+			want += uint64(v)
+			buf, _ = AppendOrderedT(buf, v, order)
+		}
+
+		var sum uint64
+		var indices []int
+		n, err := ForEachOrderedT(buf, 0, 10, order, func(i int, v uint32) error {
+			indices = append(indices, i)
+			sum += uint64(v)
+			return nil
+		})
+
+		assert.NoError(t, err, "it should not return an error")
+		assert.Equal(t, want, sum)
+		assert.Equal(t, []int{0, 1, 2, 3, 4, 5, 6, 7, 8, 9}, indices)
+		assert.Equal(t, 40, n)
+	})
+
+	t.Run("it should stop when fn returns an error", func(t *testing.T) {
+		buf := []byte{0x01, 0x02, 0x03, 0x04, 0x05}
+		errStop := errors.New("stop")
+
+		var seen []uint8
+		n, err := ForEachOrderedT(buf, 0, len(buf), nil, func(i int, v uint8) error {
+			seen = append(seen, v)
+			if v == 0x03 {
+				return errStop
+			}
+			return nil
+		})
+
+		assert.ErrorIs(t, err, errStop)
+		assert.Equal(t, []uint8{0x01, 0x02, 0x03}, seen)
+		assert.Equal(t, 3, n)
+	})
+
+	t.Run("it should return an EOF error without calling fn when the buffer is too short", func(t *testing.T) {
+		buf := make([]byte, 7)
+
+		called := false
+		n, err := ForEachOrderedT(buf, 0, 2, binary.LittleEndian, func(i int, v uint32) error {
+			called = true
+			return nil
+		})
+
+		assert.ErrorIs(t, err, io.EOF)
+		assert.False(t, called)
+		assert.Zero(t, n)
+	})
+}
+
+func TestIterOrderedT(t *testing.T) {
+	t.Run("it should iterate a packed slice", func(t *testing.T) {
+		order := binary.BigEndian
+		want := []uint32{gofakeit.Uint32(), gofakeit.Uint32(), gofakeit.Uint32()}
+		var buf []byte
+		for _, v := range want {
+			buf, _ = AppendOrderedT(buf, v, order)
+		}
+
+		var got []uint32
+		for v, err := range IterOrderedT[uint32](buf, 0, len(want), order) {
+			assert.NoError(t, err, "it should not return an error")
+			got = append(got, v)
+		}
+
+		assert.Equal(t, want, got)
+	})
+
+	t.Run("it should yield an EOF error as the final element when the buffer runs out", func(t *testing.T) {
+		buf := []byte{0x00, 0x01, 0x00, 0x02, 0x00}
+
+		var got []uint16
+		var errs []error
+		for v, err := range IterOrderedT[uint16](buf, 0, 5, binary.BigEndian) {
+			got = append(got, v)
+			errs = append(errs, err)
+		}
+
+		assert.Equal(t, []uint16{1, 2, 0}, got)
+		assert.Equal(t, []error{nil, nil, io.EOF}, errs)
+	})
+
+	t.Run("it should stop decoding when the loop breaks", func(t *testing.T) {
+		buf := []byte{0x01, 0x02, 0x03, 0x04}
+
+		var got []uint8
+		for v := range IterOrderedT[uint8](buf, 0, len(buf), nil) {
+			got = append(got, v)
+			if len(got) == 2 {
+				break
+			}
+		}
+
+		assert.Equal(t, []uint8{0x01, 0x02}, got)
+	})
+}
+
+func TestForEachOrderedT_Overflow(t *testing.T) {
+	t.Run("it should return ErrCountOverflow for counts near math.MaxInt", func(t *testing.T) {
+		n, err := ForEachOrderedT(make([]byte, 16), 0, math.MaxInt/2, nil, func(i int, v uint32) error {
+			return nil
+		})
+
+		assert.ErrorAs(t, err, &ErrCountOverflow{})
+		assert.Zero(t, n)
+	})
+}
@@ -0,0 +1,56 @@
+package buffergenerics
+
+import (
+	"encoding/binary"
+	"reflect"
+)
+
+// ReadKind reads a value of the given kind from the given buffer starting at the specified offset, using the
+// specified byte order. If the byte order is nil, it defaults to binary.NativeEndian. It is the runtime-dispatched
+// counterpart of ReadOrderedT, for interpreters over schemas whose field types are only known as a reflect.Kind.
+// It returns the value boxed in an any holding the predeclared type of that kind, e.g. uint16 for reflect.Uint16,
+// the number of bytes consumed, and any error encountered during the read operation, including ErrUnknownKind
+// if kind is not one of the supported kinds.
+// See also: ReadOrderedT.
+func ReadKind(buffer []byte, offset int, kind reflect.Kind, order binary.ByteOrder) (any, int, error) {
+	switch kind {
+	case reflect.Int:
+		return readBoxed[int](buffer, offset, order)
+	case reflect.Int8:
+		return readBoxed[int8](buffer, offset, order)
+	case reflect.Int16:
+		return readBoxed[int16](buffer, offset, order)
+	case reflect.Int32:
+		return readBoxed[int32](buffer, offset, order)
+	case reflect.Int64:
+		return readBoxed[int64](buffer, offset, order)
+	case reflect.Uint:
+		return readBoxed[uint](buffer, offset, order)
+	case reflect.Uint8:
+		return readBoxed[uint8](buffer, offset, order)
+	case reflect.Uint16:
+		return readBoxed[uint16](buffer, offset, order)
+	case reflect.Uint32:
+		return readBoxed[uint32](buffer, offset, order)
+	case reflect.Uint64:
+		return readBoxed[uint64](buffer, offset, order)
+	case reflect.Uintptr:
+		return readBoxed[uintptr](buffer, offset, order)
+	case reflect.Float32:
+		return readBoxed[float32](buffer, offset, order)
+	case reflect.Float64:
+		return readBoxed[float64](buffer, offset, order)
+	default:
+		return nil, 0, NewErrUnknownKind(kind)
+	}
+}
+
+// readBoxed reads a value of type T as with ReadOrderedT and returns it boxed, along with its size.
+func readBoxed[T Numeric](buffer []byte, offset int, order binary.ByteOrder) (any, int, error) {
+	val, err := ReadOrderedT[T](buffer, offset, order)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return val, SizeOf[T](), nil
+}
@@ -0,0 +1,61 @@
+package buffergenerics
+
+import (
+	"encoding/binary"
+	"github.com/stretchr/testify/assert"
+	"io"
+	"reflect"
+	"testing"
+)
+
+func TestReadKind(t *testing.T) {
+	buf := []byte{0x01, 0x3F, 0x80, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00}
+
+	t.Run("it should read each supported kind", func(t *testing.T) {
+		for _, kind := range supportedKinds {
+			got, n, err := ReadKind(buf, 1, kind, binary.BigEndian)
+
+			assert.NoError(t, err, "it should not return an error for %v", kind)
+			assert.Equal(t, kind, reflect.TypeOf(got).Kind(), "it should box the predeclared %v type", kind)
+			assert.Equal(t, int(reflect.TypeOf(got).Size()), n, "it should consume the size of %v", kind)
+		}
+	})
+
+	t.Run("it should match ReadOrderedT", func(t *testing.T) {
+		for _, tc := range []struct {
+			kind reflect.Kind
+			want any
+		}{
+			{reflect.Uint8, MustReadOrderedT[uint8](buf, 1, binary.BigEndian)},
+			{reflect.Int16, MustReadOrderedT[int16](buf, 1, binary.BigEndian)},
+			{reflect.Uint32, MustReadOrderedT[uint32](buf, 1, binary.BigEndian)},
+			{reflect.Float32, float32(1)},
+			{reflect.Int64, MustReadOrderedT[int64](buf, 1, binary.BigEndian)},
+			{reflect.Float64, MustReadOrderedT[float64](buf, 1, binary.BigEndian)},
+		} {
+			got, _, err := ReadKind(buf, 1, tc.kind, binary.BigEndian)
+
+			assert.NoError(t, err, "it should not return an error")
+			assert.Equal(t, tc.want, got)
+		}
+	})
+
+	t.Run("it should return ErrUnknownKind for unsupported kinds", func(t *testing.T) {
+		for _, kind := range []reflect.Kind{reflect.Bool, reflect.Complex64, reflect.String, reflect.Struct} {
+			got, n, err := ReadKind(buf, 0, kind, nil)
+
+			var errKind ErrUnknownKind
+			assert.ErrorAs(t, err, &errKind)
+			assert.Equal(t, kind, errKind.Kind)
+			assert.Nil(t, got)
+			assert.Zero(t, n)
+		}
+	})
+
+	t.Run("it should return an EOF error for short buffers", func(t *testing.T) {
+		_, n, err := ReadKind(buf, 2, reflect.Uint64, nil)
+
+		assert.ErrorIs(t, err, io.EOF)
+		assert.Zero(t, n)
+	})
+}
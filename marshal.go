@@ -0,0 +1,33 @@
+package buffergenerics
+
+import (
+	"encoding"
+	"io"
+)
+
+// ReadMarshaler passes length bytes of the given buffer starting at the specified offset to dst.UnmarshalBinary.
+// It returns the number of bytes consumed and any error returned by UnmarshalBinary, or io.EOF if the region lies
+// outside the buffer. As documented by encoding.BinaryUnmarshaler, dst must copy the data to retain it.
+func ReadMarshaler(buffer []byte, offset, length int, dst encoding.BinaryUnmarshaler) (int, error) {
+	if offset < 0 || length < 0 || length > len(buffer)-offset {
+		return 0, io.EOF
+	}
+
+	end := offset + length
+	if err := dst.UnmarshalBinary(buffer[offset:end:end]); err != nil {
+		return 0, err
+	}
+
+	return length, nil
+}
+
+// AppendMarshaler appends the result of src.MarshalBinary to the given buffer and returns the extended buffer.
+// If MarshalBinary fails, its error is returned along with the original buffer.
+func AppendMarshaler(buffer []byte, src encoding.BinaryMarshaler) ([]byte, error) {
+	data, err := src.MarshalBinary()
+	if err != nil {
+		return buffer, err
+	}
+
+	return append(buffer, data...), nil
+}
@@ -0,0 +1,79 @@
+package buffergenerics
+
+import (
+	"encoding/binary"
+	"errors"
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/stretchr/testify/assert"
+	"io"
+	"testing"
+)
+
+type testPoint struct {
+	X, Y int16
+}
+
+var errTestPointLength = errors.New("testPoint: invalid length")
+
+func (p testPoint) MarshalBinary() ([]byte, error) {
+	buf, _ := AppendOrderedT(nil, p.X, binary.BigEndian)
+	return AppendOrderedT(buf, p.Y, binary.BigEndian)
+}
+
+func (p *testPoint) UnmarshalBinary(data []byte) error {
+	if len(data) != 4 {
+		return errTestPointLength
+	}
+
+	p.X = MustReadOrderedT[int16](data, 0, binary.BigEndian)
+	p.Y = MustReadOrderedT[int16](data, 2, binary.BigEndian)
+	return nil
+}
+
+func TestReadMarshaler(t *testing.T) {
+	t.Run("it should unmarshal the region into dst", func(t *testing.T) {
+		buf := []byte{0xAA, 0x00, 0x01, 0xFF, 0xFE}
+
+		var p testPoint
+		n, err := ReadMarshaler(buf, 1, 4, &p)
+
+		assert.NoError(t, err, "it should not return an error")
+		assert.Equal(t, testPoint{X: 1, Y: -2}, p)
+		assert.Equal(t, 4, n)
+	})
+
+	t.Run("it should pass through errors from UnmarshalBinary", func(t *testing.T) {
+		var p testPoint
+		n, err := ReadMarshaler(make([]byte, 8), 0, 3, &p)
+
+		assert.ErrorIs(t, err, errTestPointLength)
+		assert.Zero(t, n)
+	})
+
+	t.Run("it should return an EOF error for out-of-range regions", func(t *testing.T) {
+		var p testPoint
+		_, err := ReadMarshaler(make([]byte, 3), 0, 4, &p)
+
+		assert.ErrorIs(t, err, io.EOF)
+	})
+}
+
+func TestAppendMarshaler(t *testing.T) {
+	t.Run("it should append the marshaled bytes", func(t *testing.T) {
+		buf, err := AppendMarshaler([]byte{0xAA}, testPoint{X: 1, Y: -2})
+
+		assert.NoError(t, err, "it should not return an error")
+		assert.Equal(t, []byte{0xAA, 0x00, 0x01, 0xFF, 0xFE}, buf)
+	})
+
+	t.Run("it should round-trip with ReadMarshaler", func(t *testing.T) {
+		want := testPoint{X: gofakeit.Int16(), Y: gofakeit.Int16()}
+		buf, _ := AppendMarshaler(nil, want)
+
+		var got testPoint
+		_, err := ReadMarshaler(buf, 0, len(buf), &got)
+
+		assert.NoError(t, err, "it should not return an error")
+		assert.Equal(t, want, got)
+	})
+}
@@ -0,0 +1,90 @@
+package buffergenerics
+
+import (
+	"encoding/binary"
+	"io"
+	"math"
+)
+
+// MatrixLayout is the order in which the elements of a matrix are stored in a buffer.
+// It is independent of the byte order of the elements themselves.
+type MatrixLayout int
+
+const (
+	// RowMajor stores each row contiguously, as in C.
+	RowMajor MatrixLayout = iota
+	// ColMajor stores each column contiguously, as in Fortran and many GPU buffers.
+	ColMajor
+)
+
+// ReadMatrixOrderedT reads a rows by cols matrix of values of type T, stored with the specified layout, from the
+// given buffer starting at the specified offset, using the specified byte order. If the byte order is nil, it
+// defaults to binary.NativeEndian. Regardless of layout, the result is indexed as matrix[row][col]. The whole matrix
+// is bounds-checked up front and backed by a single allocation, with each row sub-sliced from it. It returns io.EOF
+// if the buffer cannot hold the matrix, ErrCountOverflow if its size cannot be represented, and
+// ErrInvalidMatrixLayout if layout is neither RowMajor nor ColMajor.
+// See also: ReadSliceOrderedT.
+func ReadMatrixOrderedT[T Numeric](buffer []byte, offset, rows, cols int, layout MatrixLayout, order binary.ByteOrder) ([][]T, error) {
+	if rows < 0 || cols < 0 {
+		return nil, io.EOF
+	}
+
+	if cols > 0 && rows > math.MaxInt/cols {
+		return nil, NewErrCountOverflow(rows, cols*SizeOf[T]())
+	}
+
+	var backing []T
+	var err error
+	switch layout {
+	case RowMajor:
+		backing, err = ReadSliceOrderedT[T](buffer, offset, rows*cols, order)
+	case ColMajor:
+		backing, err = readColMajorOrderedT[T](buffer, offset, rows, cols, order)
+	default:
+		return nil, NewErrInvalidMatrixLayout(layout)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	matrix := make([][]T, rows)
+	for r := range matrix {
+		matrix[r] = backing[r*cols : (r+1)*cols : (r+1)*cols]
+	}
+
+	return matrix, nil
+}
+
+// readColMajorOrderedT reads a rows by cols column-major matrix of values of type T from the given buffer starting at
+// the specified offset, decoding each element straight into its row-major position in the returned backing slice.
+func readColMajorOrderedT[T Numeric](buffer []byte, offset, rows, cols int, order binary.ByteOrder) ([]T, error) {
+	size := SizeOf[T]()
+
+	if offset < 0 {
+		return nil, io.EOF
+	}
+
+	extent, err := extentOf(rows*cols, size, size)
+	if err != nil {
+		return nil, err
+	}
+
+	if extent > len(buffer)-offset {
+		return nil, io.EOF
+	}
+
+	backing := make([]T, rows*cols)
+	for c := 0; c < cols; c++ {
+		for r := 0; r < rows; r++ {
+			val, err := ReadOrderedT[T](buffer, offset+(c*rows+r)*size, order)
+			if err != nil {
+				return nil, err
+			}
+
+			backing[r*cols+c] = val
+		}
+	}
+
+	return backing, nil
+}
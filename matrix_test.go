@@ -0,0 +1,118 @@
+package buffergenerics
+
+import (
+	"encoding/binary"
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/stretchr/testify/assert"
+	"io"
+	"math"
+	"testing"
+)
+
+func TestReadMatrixOrderedT(t *testing.T) {
+	t.Run("it should read a 3x4 float32 matrix row-major", func(t *testing.T) {
+		order := binary.LittleEndian
+		want := make([][]float32, 3)
+		var buf []byte
+		for r := range want {
+			want[r] = make([]float32, 4)
+			for c := range want[r] {
+				want[r][c] = gofakeit.Float32()
+				buf, _ = AppendOrderedT(buf, want[r][c], order)
+			}
+		}
+
+		got, err := ReadMatrixOrderedT[float32](buf, 0, 3, 4, RowMajor, order)
+
+		assert.NoError(t, err, "it should not return an error")
+		assert.Equal(t, want, got)
+	})
+
+	t.Run("it should keep rows independent when appended to", func(t *testing.T) {
+		buf := []byte{1, 2, 3, 4}
+
+		got, _ := ReadMatrixOrderedT[uint8](buf, 0, 2, 2, RowMajor, nil)
+		_ = append(got[0], 0xFF)
+
+		assert.Equal(t, []uint8{3, 4}, got[1])
+	})
+
+	t.Run("it should return an EOF error for an over-large dimension", func(t *testing.T) {
+		buf := make([]byte, 3*4*4)
+
+		matrix, err := ReadMatrixOrderedT[float32](buf, 0, 4, 4, RowMajor, binary.LittleEndian)
+
+		assert.ErrorIs(t, err, io.EOF)
+		assert.Nil(t, matrix)
+	})
+
+	t.Run("it should return ErrCountOverflow for dimensions whose product overflows", func(t *testing.T) {
+		_, err := ReadMatrixOrderedT[float32](make([]byte, 16), 0, math.MaxInt/2, 3, RowMajor, binary.LittleEndian)
+
+		assert.ErrorAs(t, err, &ErrCountOverflow{})
+	})
+}
+
+func TestReadMatrixOrderedT_Layout(t *testing.T) {
+	t.Run("it should return ErrInvalidMatrixLayout for an unknown layout", func(t *testing.T) {
+		matrix, err := ReadMatrixOrderedT[uint8](make([]byte, 6), 0, 2, 3, MatrixLayout(7), nil)
+
+		var errLayout ErrInvalidMatrixLayout
+		assert.ErrorAs(t, err, &errLayout)
+		assert.Equal(t, MatrixLayout(7), errLayout.Layout)
+		assert.Nil(t, matrix)
+	})
+}
+
+func TestReadMatrixOrderedT_ColMajor(t *testing.T) {
+	t.Run("it should read a column-major buffer into a row-major matrix", func(t *testing.T) {
+		// | 1 2 3 |
+		// | 4 5 6 | stored column by column
+		buf := []byte{1, 4, 2, 5, 3, 6}
+
+		got, err := ReadMatrixOrderedT[uint8](buf, 0, 2, 3, ColMajor, nil)
+
+		assert.NoError(t, err, "it should not return an error")
+		assert.Equal(t, [][]uint8{{1, 2, 3}, {4, 5, 6}}, got)
+	})
+
+	t.Run("it should transpose the row-major interpretation of the same bytes", func(t *testing.T) {
+		order := binary.BigEndian
+		const rows, cols = 3, 4
+		var buf []byte
+		for i := 0; i < rows*cols; i++ {
+			buf, _ = AppendOrderedT(buf, gofakeit.Int16(), order)
+		}
+
+		rowMajor, err := ReadMatrixOrderedT[int16](buf, 0, cols, rows, RowMajor, order)
+		assert.NoError(t, err, "it should not return an error")
+
+		colMajor, err := ReadMatrixOrderedT[int16](buf, 0, rows, cols, ColMajor, order)
+		assert.NoError(t, err, "it should not return an error")
+
+		for r := 0; r < rows; r++ {
+			for c := 0; c < cols; c++ {
+				assert.Equal(t, rowMajor[c][r], colMajor[r][c])
+			}
+		}
+	})
+
+	t.Run("it should return an EOF error for an over-large dimension", func(t *testing.T) {
+		matrix, err := ReadMatrixOrderedT[uint16](make([]byte, 11), 0, 2, 3, ColMajor, nil)
+
+		assert.ErrorIs(t, err, io.EOF)
+		assert.Nil(t, matrix)
+	})
+
+	t.Run("it should return an EOF error for a negative offset", func(t *testing.T) {
+		_, err := ReadMatrixOrderedT[uint8](make([]byte, 6), -1, 2, 3, ColMajor, nil)
+
+		assert.ErrorIs(t, err, io.EOF)
+	})
+
+	t.Run("it should return ErrCountOverflow for a byte size that overflows", func(t *testing.T) {
+		_, err := ReadMatrixOrderedT[uint64](make([]byte, 16), 0, math.MaxInt/8, 2, ColMajor, nil)
+
+		assert.ErrorAs(t, err, &ErrCountOverflow{})
+	})
+}
@@ -0,0 +1,41 @@
+package buffergenerics
+
+import (
+	"net"
+	"net/netip"
+)
+
+// ReadIPv4 reads a 4-byte IPv4 address from the given buffer starting at the specified offset.
+// It returns the address and io.EOF if the buffer is too short.
+func ReadIPv4(buffer []byte, offset int) (netip.Addr, error) {
+	var a [4]byte
+	if err := ReadFixedBytesInto(buffer, offset, a[:]); err != nil {
+		return netip.Addr{}, err
+	}
+
+	return netip.AddrFrom4(a), nil
+}
+
+// ReadIPv6 reads a 16-byte IPv6 address from the given buffer starting at the specified offset.
+// IPv4-mapped addresses are returned as-is rather than unmapped; see netip.Addr.Unmap.
+// It returns the address and io.EOF if the buffer is too short.
+func ReadIPv6(buffer []byte, offset int) (netip.Addr, error) {
+	var a [16]byte
+	if err := ReadFixedBytesInto(buffer, offset, a[:]); err != nil {
+		return netip.Addr{}, err
+	}
+
+	return netip.AddrFrom16(a), nil
+}
+
+// ReadMAC reads a 6-byte EUI-48 MAC address from the given buffer starting at the specified offset.
+// It returns a copy of the address, such that modifying the buffer afterward does not affect it,
+// and io.EOF if the buffer is too short.
+func ReadMAC(buffer []byte, offset int) (net.HardwareAddr, error) {
+	mac, err := ReadBytes(buffer, offset, 6)
+	if err != nil {
+		return nil, err
+	}
+
+	return net.HardwareAddr(mac), nil
+}
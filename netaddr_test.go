@@ -0,0 +1,74 @@
+package buffergenerics
+
+import (
+	"github.com/stretchr/testify/assert"
+	"io"
+	"net"
+	"net/netip"
+	"testing"
+)
+
+func TestReadIPv4(t *testing.T) {
+	t.Run("it should read a known address", func(t *testing.T) {
+		buf := []byte{0xFF, 192, 168, 1, 42}
+
+		got, err := ReadIPv4(buf, 1)
+
+		assert.NoError(t, err, "it should not return an error")
+		assert.Equal(t, netip.MustParseAddr("192.168.1.42"), got)
+	})
+
+	t.Run("it should return an EOF error for short buffers", func(t *testing.T) {
+		got, err := ReadIPv4([]byte{192, 168, 1}, 0)
+
+		assert.ErrorIs(t, err, io.EOF)
+		assert.False(t, got.IsValid())
+	})
+}
+
+func TestReadIPv6(t *testing.T) {
+	t.Run("it should read a known address", func(t *testing.T) {
+		want := netip.MustParseAddr("2001:db8::ff00:42:8329")
+		a := want.As16()
+
+		got, err := ReadIPv6(a[:], 0)
+
+		assert.NoError(t, err, "it should not return an error")
+		assert.Equal(t, want, got)
+		assert.True(t, got.Is6())
+	})
+
+	t.Run("it should return an EOF error for short buffers", func(t *testing.T) {
+		got, err := ReadIPv6(make([]byte, 16), 1)
+
+		assert.ErrorIs(t, err, io.EOF)
+		assert.False(t, got.IsValid())
+	})
+}
+
+func TestReadMAC(t *testing.T) {
+	t.Run("it should read a 6-byte address", func(t *testing.T) {
+		buf := []byte{0x00, 0x00, 0x00, 0x1A, 0x2B, 0x3C, 0x4D, 0x5E, 0x6F, 0x08, 0x00}
+
+		got, err := ReadMAC(buf, 3)
+
+		assert.NoError(t, err, "it should not return an error")
+		assert.Equal(t, "1a:2b:3c:4d:5e:6f", got.String())
+		assert.Len(t, got, 6)
+	})
+
+	t.Run("it should not alias the buffer", func(t *testing.T) {
+		buf := []byte{0x1A, 0x2B, 0x3C, 0x4D, 0x5E, 0x6F}
+
+		got, _ := ReadMAC(buf, 0)
+		buf[0] = 0xFF
+
+		assert.Equal(t, net.HardwareAddr{0x1A, 0x2B, 0x3C, 0x4D, 0x5E, 0x6F}, got)
+	})
+
+	t.Run("it should return an EOF error on overrun", func(t *testing.T) {
+		_, err := ReadMAC(make([]byte, 8), 3)
+
+		assert.ErrorIs(t, err, io.EOF)
+	})
+}
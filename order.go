@@ -0,0 +1,102 @@
+package buffergenerics
+
+import (
+	"encoding/binary"
+	"io"
+	"slices"
+)
+
+// DefaultByteOrder is the byte order used by the functions that do not take an explicit order, such as ReadT.
+// It defaults to binary.NativeEndian and may be changed to suit a single-protocol program, e.g. to binary.BigEndian.
+// It is not synchronized: set it once during initialization, before any concurrent use of the package.
+// The Ordered functions are unaffected; a nil order passed to them always means binary.NativeEndian.
+var DefaultByteOrder binary.ByteOrder = binary.NativeEndian
+
+// swappedNativeEndian is the byte order opposite to binary.NativeEndian, delegating to either
+// binary.BigEndian or binary.LittleEndian.
+type swappedNativeEndian struct {
+	binary.ByteOrder
+	binary.AppendByteOrder
+}
+
+func (s swappedNativeEndian) String() string {
+	return "SwappedNativeEndian(" + s.ByteOrder.String() + ")"
+}
+
+// SwappedNativeEndian is whichever of binary.BigEndian and binary.LittleEndian is not the host's native order.
+// It allows tests to deterministically exercise the non-native byte order regardless of the host architecture.
+// Like binary.BigEndian and binary.LittleEndian, it also implements binary.AppendByteOrder.
+var SwappedNativeEndian binary.ByteOrder = func() swappedNativeEndian {
+	if isLittleEndian(binary.NativeEndian) {
+		return swappedNativeEndian{binary.BigEndian, binary.BigEndian}
+	}
+
+	return swappedNativeEndian{binary.LittleEndian, binary.LittleEndian}
+}()
+
+// DetectByteOrder infers the byte order of the given buffer from a known 32-bit magic value at the specified offset.
+// It reads a uint32 at offset in both binary.BigEndian and binary.LittleEndian order and returns whichever order
+// yields magic, preferring binary.BigEndian if both do. It returns io.EOF if the buffer is too short and
+// ErrUnknownByteOrder if neither order matches.
+func DetectByteOrder(buffer []byte, offset int, magic uint32) (binary.ByteOrder, error) {
+	for _, order := range []binary.ByteOrder{binary.BigEndian, binary.LittleEndian} {
+		val, err := ReadOrderedT[uint32](buffer, offset, order)
+		if err != nil {
+			return nil, err
+		}
+
+		if val == magic {
+			return order, nil
+		}
+	}
+
+	return nil, NewErrUnknownByteOrder(magic)
+}
+
+// ReadBOM reads a two-byte TIFF-style byte order mark from the given buffer starting at the specified offset.
+// It returns binary.LittleEndian for "II" and binary.BigEndian for "MM", along with the number of bytes consumed,
+// so that the result can be fed into subsequent ReadOrderedT calls. It returns io.EOF if the buffer is too short
+// and ErrInvalidBOM for any other mark.
+func ReadBOM(buffer []byte, offset int) (binary.ByteOrder, int, error) {
+	if offset < 0 || offset > len(buffer)-2 {
+		return nil, 0, io.EOF
+	}
+
+	mark := [2]byte{buffer[offset], buffer[offset+1]}
+
+	switch mark {
+	case [2]byte{'I', 'I'}:
+		return binary.LittleEndian, 2, nil
+	case [2]byte{'M', 'M'}:
+		return binary.BigEndian, 2, nil
+	default:
+		return nil, 0, NewErrInvalidBOM(mark)
+	}
+}
+
+// SwapBytesT reverses, in place, the bytes of each of count consecutive elements of type T in the given buffer
+// starting at the specified offset, converting them between big-endian and little-endian. Single-byte types are
+// left unchanged. It returns io.EOF if the region lies outside the buffer and ErrCountOverflow if count is too large
+// to be represented in bytes; the buffer is not modified on error.
+func SwapBytesT[T Numeric](buffer []byte, offset, count int) error {
+	size := SizeOf[T]()
+
+	if offset < 0 || count < 0 {
+		return io.EOF
+	}
+
+	total, err := extentOf(count, size, size)
+	if err != nil {
+		return err
+	}
+
+	if total > len(buffer)-offset {
+		return io.EOF
+	}
+
+	for i := offset; i < offset+total; i += size {
+		slices.Reverse(buffer[i : i+size])
+	}
+
+	return nil
+}
@@ -0,0 +1,197 @@
+package buffergenerics
+
+import (
+	"encoding/binary"
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/stretchr/testify/assert"
+	"io"
+	"math"
+	"math/bits"
+	"testing"
+)
+
+func TestDetectByteOrder(t *testing.T) {
+	const magic uint32 = 0xA1B2C3D4
+
+	t.Run("it should detect big-endian buffers", func(t *testing.T) {
+		buf := []byte{0x00, 0xA1, 0xB2, 0xC3, 0xD4}
+
+		order, err := DetectByteOrder(buf, 1, magic)
+
+		assert.NoError(t, err, "it should not return an error")
+		assert.Equal(t, binary.BigEndian, order)
+	})
+
+	t.Run("it should detect little-endian buffers", func(t *testing.T) {
+		buf := []byte{0xD4, 0xC3, 0xB2, 0xA1}
+
+		order, err := DetectByteOrder(buf, 0, magic)
+
+		assert.NoError(t, err, "it should not return an error")
+		assert.Equal(t, binary.LittleEndian, order)
+	})
+
+	t.Run("it should return ErrUnknownByteOrder when neither order matches", func(t *testing.T) {
+		buf := []byte{0xDE, 0xAD, 0xCA, 0xFE}
+
+		order, err := DetectByteOrder(buf, 0, magic)
+
+		var errUnknown ErrUnknownByteOrder
+		assert.ErrorAs(t, err, &errUnknown)
+		assert.Equal(t, magic, errUnknown.Magic)
+		assert.Nil(t, order)
+	})
+
+	t.Run("it should return an EOF error for short buffers", func(t *testing.T) {
+		buf := []byte{0xA1, 0xB2, 0xC3}
+
+		_, err := DetectByteOrder(buf, 0, magic)
+
+		assert.ErrorIs(t, err, io.EOF)
+	})
+}
+
+func TestReadBOM(t *testing.T) {
+	t.Run("it should return binary.LittleEndian for II", func(t *testing.T) {
+		buf := []byte("II*\x00")
+
+		order, n, err := ReadBOM(buf, 0)
+
+		assert.NoError(t, err, "it should not return an error")
+		assert.Equal(t, binary.LittleEndian, order)
+		assert.Equal(t, 2, n)
+	})
+
+	t.Run("it should return binary.BigEndian for MM", func(t *testing.T) {
+		buf := []byte("\x00MM\x00*")
+
+		order, n, err := ReadBOM(buf, 1)
+
+		assert.NoError(t, err, "it should not return an error")
+		assert.Equal(t, binary.BigEndian, order)
+		assert.Equal(t, 2, n)
+	})
+
+	t.Run("it should allow the result to drive subsequent reads", func(t *testing.T) {
+		buf := []byte("MM\x00*")
+
+		order, n, _ := ReadBOM(buf, 0)
+		magic, err := ReadOrderedT[uint16](buf, n, order)
+
+		assert.NoError(t, err, "it should not return an error")
+		assert.Equal(t, uint16(42), magic)
+	})
+
+	t.Run("it should return ErrInvalidBOM for other marks", func(t *testing.T) {
+		buf := []byte("IM*\x00")
+
+		order, n, err := ReadBOM(buf, 0)
+
+		var errBOM ErrInvalidBOM
+		assert.ErrorAs(t, err, &errBOM)
+		assert.Equal(t, [2]byte{'I', 'M'}, errBOM.Mark)
+		assert.Nil(t, order)
+		assert.Zero(t, n)
+	})
+
+	t.Run("it should return an EOF error for short buffers", func(t *testing.T) {
+		_, _, err := ReadBOM([]byte("I"), 0)
+
+		assert.ErrorIs(t, err, io.EOF)
+	})
+
+	t.Run("it should return an EOF error for huge offsets without overflowing", func(t *testing.T) {
+		buf := []byte("MM\x00\x2AMM\x00\x2A")
+
+		assert.NotPanics(t, func() {
+			_, _, err := ReadBOM(buf, math.MaxInt)
+			assert.ErrorIs(t, err, io.EOF)
+		})
+	})
+}
+
+func TestSwapBytesT(t *testing.T) {
+	t.Run("it should byte-swap each element of a uint32 array", func(t *testing.T) {
+		want := []uint32{gofakeit.Uint32(), gofakeit.Uint32(), gofakeit.Uint32()}
+		buf := []byte{0xAA}
+		for _, v := range want {
+			buf, _ = AppendOrderedT(buf, v, binary.NativeEndian)
+		}
+
+		err := SwapBytesT[uint32](buf, 1, len(want))
+		assert.NoError(t, err, "it should not return an error")
+
+		for i, v := range want {
+			got, _ := ReadOrderedT[uint32](buf, 1+i*4, binary.NativeEndian)
+			assert.Equal(t, bits.ReverseBytes32(v), got)
+		}
+		assert.Equal(t, byte(0xAA), buf[0])
+	})
+
+	t.Run("it should convert between big-endian and little-endian", func(t *testing.T) {
+		want := gofakeit.Int64()
+		buf, _ := AppendOrderedT(nil, want, binary.BigEndian)
+
+		assert.NoError(t, SwapBytesT[int64](buf, 0, 1))
+
+		got, _ := ReadOrderedT[int64](buf, 0, binary.LittleEndian)
+		assert.Equal(t, want, got)
+	})
+
+	t.Run("it should be a no-op for single-byte types", func(t *testing.T) {
+		buf := []byte{0x01, 0x02, 0x03}
+
+		assert.NoError(t, SwapBytesT[uint8](buf, 0, 3))
+		assert.Equal(t, []byte{0x01, 0x02, 0x03}, buf)
+	})
+
+	t.Run("it should return an EOF error and not modify the buffer when out of range", func(t *testing.T) {
+		buf := []byte{0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07}
+
+		err := SwapBytesT[uint32](buf, 0, 2)
+
+		assert.ErrorIs(t, err, io.EOF)
+		assert.Equal(t, []byte{0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07}, buf)
+	})
+}
+
+func TestSwappedNativeEndian(t *testing.T) {
+	t.Run("it should differ from binary.NativeEndian", func(t *testing.T) {
+		buf := []byte{0x01, 0x02}
+
+		assert.NotEqual(t, binary.NativeEndian.Uint16(buf), SwappedNativeEndian.Uint16(buf))
+		assert.NotEqual(t, isLittleEndian(binary.NativeEndian), isLittleEndian(SwappedNativeEndian))
+	})
+
+	t.Run("it should round-trip uint64", func(t *testing.T) {
+		want := gofakeit.Uint64()
+		buf := make([]byte, 8)
+		SwappedNativeEndian.PutUint64(buf, want)
+
+		got, err := ReadOrderedT[uint64](buf, 0, SwappedNativeEndian)
+
+		assert.NoError(t, err, "it should not return an error")
+		assert.Equal(t, want, got)
+		assert.Equal(t, bits.ReverseBytes64(want), binary.NativeEndian.Uint64(buf))
+	})
+
+	t.Run("it should describe itself", func(t *testing.T) {
+		assert.Contains(t, []string{
+			"SwappedNativeEndian(BigEndian)",
+			"SwappedNativeEndian(LittleEndian)",
+		}, SwappedNativeEndian.String())
+	})
+
+	t.Run("it should support appending", func(t *testing.T) {
+		appender, ok := SwappedNativeEndian.(binary.AppendByteOrder)
+		assert.True(t, ok, "it should implement binary.AppendByteOrder")
+
+		buf := appender.AppendUint16(nil, 0x0102)
+
+		assert.Equal(t, uint16(0x0201), binary.NativeEndian.Uint16(buf))
+	})
+
+	t.Run("it should be recognized as a standard byte order", func(t *testing.T) {
+		assert.True(t, isStandardOrder(SwappedNativeEndian))
+	})
+}
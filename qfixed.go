@@ -0,0 +1,61 @@
+package buffergenerics
+
+import (
+	"encoding/binary"
+	"golang.org/x/exp/constraints"
+	"math"
+)
+
+// ReadQFixedOrdered reads a Q-format fixed-point number stored as a signed integer of type T from the given buffer
+// starting at the specified offset, using the specified byte order. If the byte order is nil, it defaults to
+// binary.NativeEndian. The integer is scaled by 2^-fracBits, so Q15 is ReadQFixedOrdered[int16] with 15 fractional
+// bits and Q16.16 is ReadQFixedOrdered[int32] with 16. It returns the value, ErrInvalidBitCount if fracBits is
+// outside 0 to 64, and any error encountered during the read operation.
+// See also: AppendQFixedOrdered.
+func ReadQFixedOrdered[T constraints.Signed](buffer []byte, offset, fracBits int, order binary.ByteOrder) (float64, error) {
+	if fracBits < 0 || fracBits > 64 {
+		return 0, NewErrInvalidBitCount(fracBits)
+	}
+
+	val, err := ReadOrderedT[T](buffer, offset, order)
+	if err != nil {
+		return 0, err
+	}
+
+	return math.Ldexp(float64(val), -fracBits), nil
+}
+
+// AppendQFixedOrdered appends value as a Q-format fixed-point number with fracBits fractional bits, stored as a
+// signed integer of type T, to the given buffer, using the specified byte order. If the byte order is nil, it
+// defaults to binary.NativeEndian. The value is scaled by 2^fracBits and rounded to the nearest integer, half away
+// from zero. Out-of-range values saturate to the bounds of T, as is conventional for DSP arithmetic, and NaN is
+// encoded as zero. It returns the extended buffer, or the original buffer along with ErrInvalidBitCount if
+// fracBits is outside 0 to 64.
+// See also: ReadQFixedOrdered.
+func AppendQFixedOrdered[T constraints.Signed](buffer []byte, value float64, fracBits int, order binary.ByteOrder) ([]byte, error) {
+	if fracBits < 0 || fracBits > 64 {
+		return buffer, NewErrInvalidBitCount(fracBits)
+	}
+
+	// The bounds of T are -2^(bits-1) and 2^(bits-1)-1; only the former is exact as a float64 for every T,
+	// so the comparisons are made against it and the saturated values are computed as integers.
+	bits := SizeOf[T]() * 8
+	minT := T(1) << (bits - 1)
+	maxT := minT - 1
+	lower := -math.Ldexp(1, bits-1)
+	scaled := math.Round(math.Ldexp(value, fracBits))
+
+	var fixed T
+	switch {
+	case math.IsNaN(scaled):
+		fixed = 0
+	case scaled < lower:
+		fixed = minT
+	case scaled >= -lower:
+		fixed = maxT
+	default:
+		fixed = T(scaled)
+	}
+
+	return AppendOrderedT[T](buffer, fixed, order)
+}
@@ -0,0 +1,131 @@
+package buffergenerics
+
+import (
+	"encoding/binary"
+	"github.com/stretchr/testify/assert"
+	"io"
+	"math"
+	"testing"
+)
+
+func TestReadQFixedOrdered(t *testing.T) {
+	t.Run("it should read Q15 values in both orders", func(t *testing.T) {
+		for _, tc := range []struct {
+			bits uint16
+			want float64
+		}{
+			{0x4000, 0.5},
+			{0xC000, -0.5},
+			{0x7FFF, 32767.0 / 32768},
+			{0x8000, -1},
+			{0x0001, 1.0 / 32768},
+		} {
+			for _, order := range []binary.ByteOrder{binary.BigEndian, binary.LittleEndian} {
+				buf, _ := AppendOrderedT(nil, tc.bits, order)
+
+				got, err := ReadQFixedOrdered[int16](buf, 0, 15, order)
+
+				assert.NoError(t, err, "it should not return an error")
+				assert.Equal(t, tc.want, got, "%#04x in %v", tc.bits, order)
+			}
+		}
+	})
+
+	t.Run("it should read Q16.16 values in both orders", func(t *testing.T) {
+		for _, tc := range []struct {
+			bits uint32
+			want float64
+		}{
+			{0x00010000, 1},
+			{0x00018000, 1.5},
+			{0xFFFF0000, -1},
+			{0x7FFFFFFF, 32767.9999847412109375},
+			{0x80000000, -32768},
+		} {
+			for _, order := range []binary.ByteOrder{binary.BigEndian, binary.LittleEndian} {
+				buf, _ := AppendOrderedT(nil, tc.bits, order)
+
+				got, err := ReadQFixedOrdered[int32](buf, 0, 16, order)
+
+				assert.NoError(t, err, "it should not return an error")
+				assert.Equal(t, tc.want, got, "%#08x in %v", tc.bits, order)
+			}
+		}
+	})
+
+	t.Run("it should return ErrInvalidBitCount for unsupported fractional bits", func(t *testing.T) {
+		_, err := ReadQFixedOrdered[int16](make([]byte, 2), 0, -1, nil)
+
+		var errBits ErrInvalidBitCount
+		assert.ErrorAs(t, err, &errBits)
+		assert.Equal(t, -1, errBits.Count)
+	})
+
+	t.Run("it should return an EOF error for short buffers", func(t *testing.T) {
+		_, err := ReadQFixedOrdered[int32](make([]byte, 3), 0, 16, nil)
+
+		assert.ErrorIs(t, err, io.EOF)
+	})
+}
+
+func TestAppendQFixedOrdered(t *testing.T) {
+	t.Run("it should round-trip with ReadQFixedOrdered within the representable precision", func(t *testing.T) {
+		for _, want := range []float64{0, 0.25, -0.75, 0.123456, -0.999} {
+			buf, err := AppendQFixedOrdered[int16](nil, want, 15, binary.BigEndian)
+			assert.NoError(t, err, "it should not return an error")
+
+			got, err := ReadQFixedOrdered[int16](buf, 0, 15, binary.BigEndian)
+
+			assert.NoError(t, err, "it should not return an error")
+			assert.InDelta(t, want, got, 1.0/(1<<16))
+		}
+
+		buf, _ := AppendQFixedOrdered[int32]([]byte{0xAA}, -1234.56789, 16, binary.LittleEndian)
+		got, _ := ReadQFixedOrdered[int32](buf, 1, 16, binary.LittleEndian)
+		assert.InDelta(t, -1234.56789, got, 1.0/(1<<17))
+	})
+
+	t.Run("it should round to the nearest integer", func(t *testing.T) {
+		buf, _ := AppendQFixedOrdered[int16](nil, 1.6/(1<<15), 15, binary.BigEndian)
+
+		assert.Equal(t, []byte{0x00, 0x02}, buf)
+	})
+
+	t.Run("it should saturate Q15 at the extremes", func(t *testing.T) {
+		for _, tc := range []struct {
+			value float64
+			want  []byte
+		}{
+			{1, []byte{0x7F, 0xFF}},
+			{1e9, []byte{0x7F, 0xFF}},
+			{math.Inf(1), []byte{0x7F, 0xFF}},
+			{-1, []byte{0x80, 0x00}},
+			{-1.5, []byte{0x80, 0x00}},
+			{math.Inf(-1), []byte{0x80, 0x00}},
+			{math.NaN(), []byte{0x00, 0x00}},
+		} {
+			buf, err := AppendQFixedOrdered[int16](nil, tc.value, 15, binary.BigEndian)
+
+			assert.NoError(t, err, "it should not return an error")
+			assert.Equal(t, tc.want, buf, "%g", tc.value)
+		}
+	})
+
+	t.Run("it should saturate 64-bit values exactly", func(t *testing.T) {
+		hi, _ := AppendQFixedOrdered[int64](nil, 1e30, 0, binary.BigEndian)
+		lo, _ := AppendQFixedOrdered[int64](nil, -1e30, 0, binary.BigEndian)
+
+		assert.Equal(t, int64(math.MaxInt64), MustReadOrderedT[int64](hi, 0, binary.BigEndian))
+		assert.Equal(t, int64(math.MinInt64), MustReadOrderedT[int64](lo, 0, binary.BigEndian))
+	})
+
+	t.Run("it should return ErrInvalidBitCount for unsupported fractional bits", func(t *testing.T) {
+		buf := []byte{0xAA}
+
+		got, err := AppendQFixedOrdered[int16](buf, 0.5, 65, nil)
+
+		var errBits ErrInvalidBitCount
+		assert.ErrorAs(t, err, &errBits)
+		assert.Equal(t, buf, got)
+	})
+}
@@ -2,58 +2,143 @@ package buffergenerics
 
 import (
 	"encoding/binary"
-	"golang.org/x/exp/constraints"
 	"io"
 	"math"
+	"math/bits"
 	"reflect"
+	"runtime"
+	"unsafe"
 )
 
+// supportedKinds lists the kinds that ReadOrderedT and WriteOrderedT can encode, in the order reported
+// by ErrUnknownKind.
+var supportedKinds = []reflect.Kind{
+	reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+	reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr,
+	reflect.Float32, reflect.Float64,
+}
+
+// OnRead, if non-nil, is called after each successful ReadOrderedT with the kind and size in bytes of the value read,
+// for example to count which field widths dominate a slow parse. Since most readers in this package are built on
+// ReadOrderedT, it observes their reads too. When nil, it costs a single check per read.
+// It is not synchronized: set it before any concurrent use of the package, and make it safe for concurrent calls.
+var OnRead func(kind reflect.Kind, size int)
+
 // ReadOrderedT reads a value of type T from the given buffer starting at the specified offset,
 // using the specified byte order. If the byte order is nil, it defaults to binary.NativeEndian.
 // It returns the read value and any error encountered during the read operation.
-func ReadOrderedT[T constraints.Integer | constraints.Float](buffer []byte, offset int, order binary.ByteOrder) (T, error) {
+// Platform-dependent types such as int, uint, and uintptr are read at their width on the host; see SizeOf.
+// If a custom byte order panics while decoding, for example by indexing beyond the value's width,
+// ErrOrderSliceLength is returned instead.
+func ReadOrderedT[T Numeric](buffer []byte, offset int, order binary.ByteOrder) (T, error) {
 	if order == nil {
 		order = binary.ByteOrder(binary.NativeEndian)
 	}
 
-	typ := reflect.TypeFor[T]()
-	zero := *new(T)
-	kind := typ.Kind()
-	size := typ.Bits() / 8
+	kind, size := kindAndSizeOf[T]()
 	end := offset + size
 
-	if end > len(buffer) {
-		return zero, io.EOF
+	if offset < 0 || offset > len(buffer)-size {
+		return *new(T), io.EOF
+	}
+
+	// Capping the capacity keeps a custom byte order from reading beyond the value by reslicing.
+	region := buffer[offset:end:end]
+
+	var val T
+	var err error
+	if isStandardOrder(order) {
+		val, err = decodeOrderedT[T](region, kind, order)
+	} else {
+		val, err = decodeGuardedOrderedT[T](region, kind, order)
+	}
+
+	if hook := OnRead; hook != nil && err == nil {
+		hook(kind, size)
 	}
 
+	return val, err
+}
+
+// decodeOrderedT decodes a value of type T, of the given kind, from region, which holds exactly its encoding.
+func decodeOrderedT[T Numeric](region []byte, kind reflect.Kind, order binary.ByteOrder) (T, error) {
 	switch kind {
-	case reflect.Int8, reflect.Uint8:
-		return T(buffer[offset]), nil
-	case reflect.Int16, reflect.Uint16:
-		u16 := order.Uint16(buffer[offset:end])
-		return T(u16), nil
-	case reflect.Int32, reflect.Uint32:
-		u32 := order.Uint32(buffer[offset:end])
-		return T(u32), nil
-	case reflect.Int64, reflect.Uint64, reflect.Uintptr:
-		u64 := order.Uint64(buffer[offset:end])
-		return T(u64), nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		// Integers are dispatched by width rather than kind, so that platform-dependent kinds
+		// and named types over them are encoded at their actual size.
+		switch len(region) {
+		case 1:
+			return T(region[0]), nil
+		case 2:
+			u16 := order.Uint16(region)
+			return T(u16), nil
+		case 4:
+			u32 := order.Uint32(region)
+			return T(u32), nil
+		case 8:
+			u64 := order.Uint64(region)
+			return T(u64), nil
+		}
 	case reflect.Float32:
-		fu32 := order.Uint32(buffer[offset:end])
+		fu32 := order.Uint32(region)
 		return T(math.Float32frombits(fu32)), nil
 	case reflect.Float64:
-		fu64 := order.Uint64(buffer[offset:end])
+		fu64 := order.Uint64(region)
 		return T(math.Float64frombits(fu64)), nil
-	default:
-		return zero, NewErrUnknownKind(kind)
 	}
+
+	return *new(T), NewErrUnknownKind(kind)
+}
+
+// decodeGuardedOrderedT is like decodeOrderedT, but converts a runtime panic raised by a custom byte order
+// into ErrOrderSliceLength. Any other panic is propagated.
+func decodeGuardedOrderedT[T Numeric](region []byte, kind reflect.Kind, order binary.ByteOrder) (value T, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			re, ok := r.(runtime.Error)
+			if !ok {
+				panic(r)
+			}
+
+			value, err = *new(T), NewErrOrderSliceLength(order, len(region), re)
+		}
+	}()
+
+	return decodeOrderedT[T](region, kind, order)
+}
+
+// isStandardOrder reports whether order is one of the byte orders provided by encoding/binary, or
+// SwappedNativeEndian which delegates to them, all of which are known to decode exactly the width they are given.
+func isStandardOrder(order binary.ByteOrder) bool {
+	switch order {
+	case binary.LittleEndian, binary.BigEndian, binary.NativeEndian, SwappedNativeEndian:
+		return true
+	}
+
+	return false
+}
+
+// ReadOrderedTInto reads a value of type T from the given buffer starting at the specified offset into dst,
+// using the specified byte order. If the byte order is nil, it defaults to binary.NativeEndian.
+// Since T is inferred from dst, it is convenient in generic call chains where T cannot be inferred otherwise.
+// It returns any error encountered during the read operation, in which case dst is left untouched.
+// See also: ReadOrderedT.
+func ReadOrderedTInto[T Numeric](buffer []byte, offset int, dst *T, order binary.ByteOrder) error {
+	val, err := ReadOrderedT[T](buffer, offset, order)
+	if err != nil {
+		return err
+	}
+
+	*dst = val
+	return nil
 }
 
 // MustReadOrderedT reads a value of type T from the given buffer starting at the specified offset,
 // using the specified byte order. If the byte order is nil, it defaults to binary.NativeEndian.
 // It returns the read value. If an error is encountered during the read operation, it panics with the error.
 // See also: ReadOrderedT.
-func MustReadOrderedT[T constraints.Integer | constraints.Float](buffer []byte, offset int, order binary.ByteOrder) T {
+func MustReadOrderedT[T Numeric](buffer []byte, offset int, order binary.ByteOrder) T {
 	val, err := ReadOrderedT[T](buffer, offset, order)
 	if err != nil {
 		panic(err)
@@ -65,30 +150,139 @@ func MustReadOrderedT[T constraints.Integer | constraints.Float](buffer []byte,
 // ReadOrderedTOrZero reads a value of type T from the given buffer starting at the specified offset,
 // using the specified byte order. If the byte order is nil, it defaults to binary.NativeEndian.
 // It returns the read value. If an error occurs during the read operation, it returns the zero value of type T.
-func ReadOrderedTOrZero[T constraints.Integer | constraints.Float](buffer []byte, offset int, order binary.ByteOrder) T {
+func ReadOrderedTOrZero[T Numeric](buffer []byte, offset int, order binary.ByteOrder) T {
 	val, _ := ReadOrderedT[T](buffer, offset, order)
 	return val
 }
 
+// ReadOrderedTOK reads a value of type T from the given buffer starting at the specified offset,
+// using the specified byte order. If the byte order is nil, it defaults to binary.NativeEndian.
+// It returns the read value and true on success, or the zero value of type T and false if the read fails,
+// for example because the buffer is too short. This comma-ok form suits tight loops that do not need the error.
+// See also: ReadOrderedT.
+func ReadOrderedTOK[T Numeric](buffer []byte, offset int, order binary.ByteOrder) (T, bool) {
+	val, err := ReadOrderedT[T](buffer, offset, order)
+	return val, err == nil
+}
+
 // ReadT reads a value of type T from the given buffer starting at the specified offset.
-// It uses binary.NativeEndian byte order. It returns the read value and any error encountered during the read operation.
+// It uses the package-level DefaultByteOrder. It returns the read value and any error encountered during the read operation.
 // See also: ReadOrderedT.
-func ReadT[T constraints.Integer | constraints.Float](buffer []byte, offset int) (T, error) {
-	return ReadOrderedT[T](buffer, offset, binary.NativeEndian)
+func ReadT[T Numeric](buffer []byte, offset int) (T, error) {
+	return ReadOrderedT[T](buffer, offset, DefaultByteOrder)
 }
 
 // MustReadT reads a value of type T from the given buffer starting at the specified offset.
-// It uses the default byte order binary.NativeEndian. If an error is encountered during
+// It uses the package-level DefaultByteOrder. If an error is encountered during
 // the read operation, it panics with the error.
 // See also: ReadOrderedT.
-func MustReadT[T constraints.Integer | constraints.Float](buffer []byte, offset int) T {
-	return MustReadOrderedT[T](buffer, offset, binary.NativeEndian)
+func MustReadT[T Numeric](buffer []byte, offset int) T {
+	return MustReadOrderedT[T](buffer, offset, DefaultByteOrder)
 }
 
 // ReadTOrZero reads a value of type T from the given buffer starting at the specified offset.
-// It uses the default byte order binary.NativeEndian and returns the read value.
+// It uses the package-level DefaultByteOrder and returns the read value.
 // Any error encountered during the read operation is ignored and the zero value for type T is returned instead.
-func ReadTOrZero[T constraints.Integer | constraints.Float](buffer []byte, offset int) T {
-	val, _ := ReadOrderedT[T](buffer, offset, binary.NativeEndian)
+func ReadTOrZero[T Numeric](buffer []byte, offset int) T {
+	val, _ := ReadOrderedT[T](buffer, offset, DefaultByteOrder)
 	return val
 }
+
+// SizeOf returns the size in bytes of a value of type T as encoded in a buffer.
+// This is the number of bytes consumed by ReadOrderedT for the same type.
+func SizeOf[T Numeric]() int {
+	_, size := kindAndSizeOf[T]()
+	return size
+}
+
+// kindAndSizeOf returns the kind of T and its size in bytes. The predeclared numeric types are resolved by a type
+// switch, which the compiler can specialize and which avoids reflection entirely, for example on TinyGo;
+// only named types fall back to reflect.
+func kindAndSizeOf[T Numeric]() (reflect.Kind, int) {
+	switch any(*new(T)).(type) {
+	case int8:
+		return reflect.Int8, 1
+	case int16:
+		return reflect.Int16, 2
+	case int32:
+		return reflect.Int32, 4
+	case int64:
+		return reflect.Int64, 8
+	case int:
+		return reflect.Int, bits.UintSize / 8
+	case uint8:
+		return reflect.Uint8, 1
+	case uint16:
+		return reflect.Uint16, 2
+	case uint32:
+		return reflect.Uint32, 4
+	case uint64:
+		return reflect.Uint64, 8
+	case uint:
+		return reflect.Uint, bits.UintSize / 8
+	case uintptr:
+		return reflect.Uintptr, int(unsafe.Sizeof(uintptr(0)))
+	case float32:
+		return reflect.Float32, 4
+	case float64:
+		return reflect.Float64, 8
+	}
+
+	typ := reflect.TypeFor[T]()
+	return typ.Kind(), typ.Bits() / 8
+}
+
+// CanReadSeq reports whether a sequence of reads of the given sizes, laid out consecutively starting at the
+// specified offset, fits within the given buffer. It is intended to pre-flight a parse, for example
+// CanReadSeq(buffer, offset, SizeOf[uint32](), SizeOf[uint16](), 8). A negative offset or size never fits,
+// and the sum of sizes is checked without overflow.
+func CanReadSeq(buffer []byte, offset int, sizes ...int) bool {
+	if offset < 0 || offset > len(buffer) {
+		return false
+	}
+
+	remaining := len(buffer) - offset
+	for _, size := range sizes {
+		if size < 0 || size > remaining {
+			return false
+		}
+
+		remaining -= size
+	}
+
+	return true
+}
+
+// ReadTwo reads a value of type A followed immediately by a value of type B from the given buffer starting at
+// the specified offset, using the specified byte order. If the byte order is nil, it defaults to binary.NativeEndian.
+// It returns both values, the total number of bytes consumed, and any error encountered during the read operation.
+// On error, the zero values and zero bytes consumed are returned.
+// See also: ReadOrderedT.
+func ReadTwo[A, B Numeric](buffer []byte, offset int, order binary.ByteOrder) (A, B, int, error) {
+	a, err := ReadOrderedT[A](buffer, offset, order)
+	if err != nil {
+		return *new(A), *new(B), 0, err
+	}
+
+	sizeA := SizeOf[A]()
+	b, err := ReadOrderedT[B](buffer, offset+sizeA, order)
+	if err != nil {
+		return *new(A), *new(B), 0, err
+	}
+
+	return a, b, sizeA + SizeOf[B](), nil
+}
+
+// ReadAdvanceOrderedT reads a value of type T from the given buffer starting at the specified offset,
+// using the specified byte order. If the byte order is nil, it defaults to binary.NativeEndian.
+// It returns the read value, the offset immediately following it, and any error encountered during the read
+// operation, which allows chaining reads with explicit offsets. On error, the returned offset is the original offset.
+// See also: ReadOrderedT.
+func ReadAdvanceOrderedT[T Numeric](buffer []byte, offset int, order binary.ByteOrder) (value T, next int, err error) {
+	value, err = ReadOrderedT[T](buffer, offset, order)
+	if err != nil {
+		return value, offset, err
+	}
+
+	return value, offset + SizeOf[T](), nil
+}
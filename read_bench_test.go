@@ -0,0 +1,62 @@
+package buffergenerics
+
+import (
+	"bytes"
+	"encoding/binary"
+	"reflect"
+	"testing"
+)
+
+func benchmarkReadOrderedT[T Numeric](b *testing.B) {
+	buf := make([]byte, 8)
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		_, _ = ReadOrderedT[T](buf, 0, binary.LittleEndian)
+	}
+}
+
+func BenchmarkReadOrderedT_Uint8(b *testing.B)   { benchmarkReadOrderedT[uint8](b) }
+func BenchmarkReadOrderedT_Uint32(b *testing.B)  { benchmarkReadOrderedT[uint32](b) }
+func BenchmarkReadOrderedT_Uint64(b *testing.B)  { benchmarkReadOrderedT[uint64](b) }
+func BenchmarkReadOrderedT_Float64(b *testing.B) { benchmarkReadOrderedT[float64](b) }
+
+// BenchmarkReadOrderedT_OnRead measures the cost of an installed hook; compare BenchmarkReadOrderedT_Uint64,
+// which runs with OnRead unset.
+func BenchmarkReadOrderedT_OnRead(b *testing.B) {
+	var count int
+	OnRead = func(reflect.Kind, int) { count++ }
+	b.Cleanup(func() { OnRead = nil })
+
+	benchmarkReadOrderedT[uint64](b)
+}
+
+func BenchmarkReadOrderedT_EOF(b *testing.B) {
+	buf := make([]byte, 4)
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		_, _ = ReadOrderedT[uint64](buf, 0, binary.LittleEndian)
+	}
+}
+
+// benchmarkBinaryRead is the encoding/binary.Read baseline for benchmarkReadOrderedT.
+func benchmarkBinaryRead[T Numeric](b *testing.B) {
+	buf := make([]byte, 8)
+	r := bytes.NewReader(buf)
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		var v T
+		r.Reset(buf)
+		_ = binary.Read(r, binary.LittleEndian, &v)
+	}
+}
+
+func BenchmarkBinaryRead_Uint8(b *testing.B)   { benchmarkBinaryRead[uint8](b) }
+func BenchmarkBinaryRead_Uint32(b *testing.B)  { benchmarkBinaryRead[uint32](b) }
+func BenchmarkBinaryRead_Uint64(b *testing.B)  { benchmarkBinaryRead[uint64](b) }
+func BenchmarkBinaryRead_Float64(b *testing.B) { benchmarkBinaryRead[float64](b) }
@@ -0,0 +1,50 @@
+package buffergenerics
+
+import (
+	"encoding/binary"
+	"math"
+	"testing"
+)
+
+func fuzzReadOrderedT[T Numeric](t *testing.T, buf []byte, offset int, order binary.ByteOrder) {
+	_, err := ReadOrderedT[T](buf, offset, order)
+	inBounds := offset >= 0 && offset <= len(buf)-SizeOf[T]()
+
+	if inBounds && err != nil {
+		t.Errorf("ReadOrderedT[%T](len=%d, offset=%d) returned an error for an in-bounds read: %v",
+			*new(T), len(buf), offset, err)
+	}
+
+	if !inBounds && err == nil {
+		t.Errorf("ReadOrderedT[%T](len=%d, offset=%d) returned no error for an out-of-bounds read",
+			*new(T), len(buf), offset)
+	}
+}
+
+func FuzzReadOrderedT(f *testing.F) {
+	f.Add([]byte{}, 0, false)
+	f.Add([]byte{0xDE, 0xAD, 0xCA, 0xFE}, 4, true)
+	f.Add([]byte{0xDE, 0xAD, 0xCA, 0xFE}, -1, false)
+	f.Add([]byte{0xDE, 0xAD, 0xCA, 0xFE}, math.MaxInt, true)
+	f.Add([]byte{0xDE, 0xAD, 0xCA, 0xFE}, math.MinInt, false)
+	f.Add(make([]byte, 16), 8, true)
+
+	f.Fuzz(func(t *testing.T, buf []byte, offset int, bigEndian bool) {
+		var order binary.ByteOrder = binary.LittleEndian
+		if bigEndian {
+			order = binary.BigEndian
+		}
+
+		fuzzReadOrderedT[int8](t, buf, offset, order)
+		fuzzReadOrderedT[uint8](t, buf, offset, order)
+		fuzzReadOrderedT[int16](t, buf, offset, order)
+		fuzzReadOrderedT[uint16](t, buf, offset, order)
+		fuzzReadOrderedT[int32](t, buf, offset, order)
+		fuzzReadOrderedT[uint32](t, buf, offset, order)
+		fuzzReadOrderedT[int64](t, buf, offset, order)
+		fuzzReadOrderedT[uint64](t, buf, offset, order)
+		fuzzReadOrderedT[uintptr](t, buf, offset, order)
+		fuzzReadOrderedT[float32](t, buf, offset, order)
+		fuzzReadOrderedT[float64](t, buf, offset, order)
+	})
+}
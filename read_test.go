@@ -6,7 +6,9 @@ import (
 	"github.com/stretchr/testify/assert"
 	"io"
 	"math"
+	"reflect"
 	"testing"
+	"time"
 )
 
 func TestReadOrderedT(t *testing.T) {
@@ -35,6 +37,30 @@ func TestReadOrderedT(t *testing.T) {
 	})
 }
 
+func TestReadOrderedTInto(t *testing.T) {
+	t.Run("it should infer T from the destination pointer", func(t *testing.T) {
+		want := gofakeit.Uint32()
+		buf := make([]byte, 4)
+		binary.NativeEndian.PutUint32(buf, want)
+
+		var x uint32
+		err := ReadOrderedTInto(buf, 0, &x, nil)
+
+		assert.NoError(t, err, "it should not return an error")
+		assert.Equal(t, want, x)
+	})
+
+	t.Run("it should leave the destination untouched on error", func(t *testing.T) {
+		buf := []byte{0xDE, 0xAD}
+
+		x := int64(42)
+		err := ReadOrderedTInto(buf, 0, &x, binary.LittleEndian)
+
+		assert.ErrorIs(t, err, io.EOF)
+		assert.Equal(t, int64(42), x)
+	})
+}
+
 func TestMustReadOrderedT(t *testing.T) {
 	t.Run("it should panic with EOF for out-of-bounds reads", func(t *testing.T) {
 		assert.PanicsWithError(t, "EOF", func() {
@@ -64,6 +90,36 @@ func TestMustReadOrderedT(t *testing.T) {
 	})
 }
 
+func TestReadOrderedTOK(t *testing.T) {
+	t.Run("it should agree with ReadOrderedT on success", func(t *testing.T) {
+		buf := []byte{0xDE, 0xAD, 0xBE, 0xEF, 0xCA, 0xFE}
+
+		for offset := 0; offset <= 2; offset++ {
+			want, err := ReadOrderedT[uint32](buf, offset, binary.BigEndian)
+			assert.NoError(t, err, "it should not return an error")
+
+			got, ok := ReadOrderedTOK[uint32](buf, offset, binary.BigEndian)
+
+			assert.True(t, ok)
+			assert.Equal(t, want, got)
+		}
+	})
+
+	t.Run("it should return false and the zero value on failure", func(t *testing.T) {
+		buf := []byte{0xDE, 0xAD, 0xBE, 0xEF}
+
+		for _, offset := range []int{-1, 1, 4, 5} {
+			_, err := ReadOrderedT[uint32](buf, offset, nil)
+			assert.Error(t, err)
+
+			got, ok := ReadOrderedTOK[uint32](buf, offset, nil)
+
+			assert.False(t, ok, "offset %d", offset)
+			assert.Zero(t, got)
+		}
+	})
+}
+
 func TestReadT(t *testing.T) {
 	t.Run("it should passthrough to ReadOrderedT using binary.NativeEndian order", func(t *testing.T) {
 		want := gofakeit.Int64()
@@ -77,6 +133,41 @@ func TestReadT(t *testing.T) {
 	})
 }
 
+func TestReadT_DefaultByteOrder(t *testing.T) {
+	t.Cleanup(func() { DefaultByteOrder = binary.NativeEndian })
+
+	t.Run("it should honor a changed DefaultByteOrder", func(t *testing.T) {
+		buf := []byte{0xDE, 0xAD, 0xCA, 0xFE}
+
+		DefaultByteOrder = binary.BigEndian
+		big, err := ReadT[uint32](buf, 0)
+		assert.NoError(t, err, "it should not return an error")
+		assert.Equal(t, uint32(0xDEADCAFE), big)
+		assert.Equal(t, uint32(0xDEADCAFE), MustReadT[uint32](buf, 0))
+		assert.Equal(t, uint32(0xDEADCAFE), ReadTOrZero[uint32](buf, 0))
+
+		DefaultByteOrder = binary.LittleEndian
+		little, err := ReadT[uint32](buf, 0)
+		assert.NoError(t, err, "it should not return an error")
+		assert.Equal(t, uint32(0xFECAADDE), little)
+		assert.Equal(t, uint32(0xFECAADDE), MustReadT[uint32](buf, 0))
+		assert.Equal(t, uint32(0xFECAADDE), ReadTOrZero[uint32](buf, 0))
+	})
+
+	t.Run("it should not affect the nil order of ReadOrderedT", func(t *testing.T) {
+		buf := []byte{0xDE, 0xAD, 0xCA, 0xFE}
+		want := binary.NativeEndian.Uint32(buf)
+
+		for _, order := range []binary.ByteOrder{binary.BigEndian, binary.LittleEndian} {
+			DefaultByteOrder = order
+			got, err := ReadOrderedT[uint32](buf, 0, nil)
+
+			assert.NoError(t, err, "it should not return an error")
+			assert.Equal(t, want, got)
+		}
+	})
+}
+
 func TestMustReadT(t *testing.T) {
 	t.Run("it should passthrough to MustReadOrderedT using binary.NativeEndian order", func(t *testing.T) {
 		assert.NotPanics(t, func() {
@@ -137,6 +228,53 @@ func TestReadOrderedT_SingleByte(t *testing.T) {
 func doTestReadOrderedT_Order(t *testing.T, order binary.ByteOrder) {
 	name := order.String()
 
+	t.Run("it should handle int "+name+" reads at the platform width", func(t *testing.T) {
+		want := int(gofakeit.Int32())
+		buf, err := AppendOrderedT(nil, want, order)
+		assert.NoError(t, err, "it should not return an error")
+		assert.Len(t, buf, SizeOf[int]())
+
+		got, err := ReadOrderedT[int](buf, 0, order)
+
+		assert.NoError(t, err, "it should not return an error")
+		assert.Equal(t, want, got)
+	})
+
+	t.Run("it should handle uint "+name+" reads at the platform width", func(t *testing.T) {
+		want := uint(gofakeit.Uint32())
+		buf, err := AppendOrderedT(nil, want, order)
+		assert.NoError(t, err, "it should not return an error")
+		assert.Len(t, buf, SizeOf[uint]())
+
+		got, err := ReadOrderedT[uint](buf, 0, order)
+
+		assert.NoError(t, err, "it should not return an error")
+		assert.Equal(t, want, got)
+	})
+
+	t.Run("it should handle named types over platform-dependent kinds in "+name+" order", func(t *testing.T) {
+		type bigSample int
+		type sample uint
+		type ptr uintptr
+		wantBig, wantSample, wantPtr := bigSample(-gofakeit.Int32()), sample(gofakeit.Uint32()), ptr(gofakeit.Uint32())
+
+		buf, _ := AppendOrderedT(nil, wantBig, order)
+		buf, _ = AppendOrderedT(buf, wantSample, order)
+		buf, _ = AppendOrderedT(buf, wantPtr, order)
+
+		gotBig, off, err := ReadAdvanceOrderedT[bigSample](buf, 0, order)
+		assert.NoError(t, err, "it should not return an error")
+		gotSample, off, err := ReadAdvanceOrderedT[sample](buf, off, order)
+		assert.NoError(t, err, "it should not return an error")
+		gotPtr, off, err := ReadAdvanceOrderedT[ptr](buf, off, order)
+		assert.NoError(t, err, "it should not return an error")
+
+		assert.Equal(t, wantBig, gotBig)
+		assert.Equal(t, wantSample, gotSample)
+		assert.Equal(t, wantPtr, gotPtr)
+		assert.Equal(t, len(buf), off)
+	})
+
 	t.Run("it should handle custom "+name+" multibyte types", func(t *testing.T) {
 		type myType int32
 		want := myType(gofakeit.Int32())
@@ -260,3 +398,247 @@ func TestReadOrderedT_LittleEndian(t *testing.T) {
 func TestReadOrderedT_NativeEndian(t *testing.T) {
 	doTestReadOrderedT_Order(t, binary.NativeEndian)
 }
+
+func TestSizeOf(t *testing.T) {
+	t.Run("it should return the encoded size of each type", func(t *testing.T) {
+		assert.Equal(t, 1, SizeOf[uint8]())
+		assert.Equal(t, 2, SizeOf[int16]())
+		assert.Equal(t, 4, SizeOf[float32]())
+		assert.Equal(t, 8, SizeOf[uint64]())
+	})
+}
+
+func TestCanReadSeq(t *testing.T) {
+	t.Run("it should report an exact fit", func(t *testing.T) {
+		buf := make([]byte, 16)
+
+		assert.True(t, CanReadSeq(buf, 2, SizeOf[uint32](), SizeOf[uint16](), 8))
+	})
+
+	t.Run("it should report a sequence one byte short", func(t *testing.T) {
+		buf := make([]byte, 15)
+
+		assert.False(t, CanReadSeq(buf, 2, SizeOf[uint32](), SizeOf[uint16](), 8))
+	})
+
+	t.Run("it should report an empty sequence within the buffer", func(t *testing.T) {
+		assert.True(t, CanReadSeq(make([]byte, 4), 4))
+		assert.False(t, CanReadSeq(make([]byte, 4), 5))
+	})
+
+	t.Run("it should reject negative offsets and sizes", func(t *testing.T) {
+		buf := make([]byte, 8)
+
+		assert.False(t, CanReadSeq(buf, -1, 1))
+		assert.False(t, CanReadSeq(buf, 0, 4, -2, 4))
+	})
+
+	t.Run("it should not overflow on huge sizes", func(t *testing.T) {
+		buf := make([]byte, 8)
+
+		assert.False(t, CanReadSeq(buf, 0, math.MaxInt, math.MaxInt, 2))
+		assert.False(t, CanReadSeq(buf, 4, 2, math.MaxInt))
+	})
+}
+
+func TestReadTwo(t *testing.T) {
+	t.Run("it should read a pair and advance by the sum of their sizes", func(t *testing.T) {
+		order := binary.BigEndian
+		want32, want16 := gofakeit.Uint32(), gofakeit.Uint16()
+		buf := make([]byte, 1+4+2)
+		order.PutUint32(buf[1:], want32)
+		order.PutUint16(buf[5:], want16)
+
+		a, b, n, err := ReadTwo[uint32, uint16](buf, 1, order)
+
+		assert.NoError(t, err, "it should not return an error")
+		assert.Equal(t, want32, a)
+		assert.Equal(t, want16, b)
+		assert.Equal(t, SizeOf[uint32]()+SizeOf[uint16](), n)
+	})
+
+	t.Run("it should return an EOF error and zero values if the second value overruns", func(t *testing.T) {
+		buf := []byte{0xDE, 0xAD, 0xCA, 0xFE, 0x01}
+
+		a, b, n, err := ReadTwo[uint32, uint16](buf, 0, binary.BigEndian)
+
+		assert.ErrorIs(t, err, io.EOF)
+		assert.Zero(t, a)
+		assert.Zero(t, b)
+		assert.Zero(t, n)
+	})
+}
+
+func TestReadOrderedT_NegativeOffset(t *testing.T) {
+	t.Run("it should return an EOF error for negative offsets", func(t *testing.T) {
+		buf := []byte{0xDE, 0xAD, 0xCA, 0xFE}
+
+		_, err := ReadOrderedT[byte](buf, -1, binary.LittleEndian)
+		assert.ErrorIs(t, err, io.EOF)
+
+		_, err = ReadOrderedT[uint16](buf, -1, binary.LittleEndian)
+		assert.ErrorIs(t, err, io.EOF)
+	})
+
+	t.Run("it should return an EOF error for offsets that overflow when advanced", func(t *testing.T) {
+		buf := []byte{0xDE, 0xAD, 0xCA, 0xFE}
+
+		_, err := ReadOrderedT[uint64](buf, math.MaxInt, binary.LittleEndian)
+
+		assert.ErrorIs(t, err, io.EOF)
+	})
+}
+
+func TestReadAdvanceOrderedT(t *testing.T) {
+	t.Run("it should chain reads of different widths", func(t *testing.T) {
+		order := binary.BigEndian
+		want8, want32, want16 := gofakeit.Uint8(), gofakeit.Uint32(), gofakeit.Int16()
+		buf, _ := AppendOrderedT(nil, want8, order)
+		buf, _ = AppendOrderedT(buf, want32, order)
+		buf, _ = AppendOrderedT(buf, want16, order)
+
+		off := 0
+		got8, off, err := ReadAdvanceOrderedT[uint8](buf, off, order)
+		assert.NoError(t, err, "it should not return an error")
+		assert.Equal(t, want8, got8)
+		assert.Equal(t, 1, off)
+
+		got32, off, err := ReadAdvanceOrderedT[uint32](buf, off, order)
+		assert.NoError(t, err, "it should not return an error")
+		assert.Equal(t, want32, got32)
+		assert.Equal(t, 5, off)
+
+		got16, off, err := ReadAdvanceOrderedT[int16](buf, off, order)
+		assert.NoError(t, err, "it should not return an error")
+		assert.Equal(t, want16, got16)
+		assert.Equal(t, 7, off)
+	})
+
+	t.Run("it should return an EOF error and the original offset on overrun", func(t *testing.T) {
+		buf := []byte{0xDE, 0xAD, 0xCA}
+
+		_, next, err := ReadAdvanceOrderedT[uint16](buf, 2, binary.BigEndian)
+
+		assert.ErrorIs(t, err, io.EOF)
+		assert.Equal(t, 2, next)
+	})
+}
+
+// brokenOrder is a deliberately buggy byte order whose Uint32 reads eight bytes.
+type brokenOrder struct {
+	binary.ByteOrder
+}
+
+func (brokenOrder) Uint32(b []byte) uint32 {
+	return uint32(binary.BigEndian.Uint64(b[:8]))
+}
+
+func TestReadOrderedT_BrokenOrder(t *testing.T) {
+	order := brokenOrder{binary.BigEndian}
+
+	t.Run("it should return ErrOrderSliceLength instead of panicking", func(t *testing.T) {
+		buf := make([]byte, 16)
+
+		var got uint32
+		var err error
+		assert.NotPanics(t, func() {
+			got, err = ReadOrderedT[uint32](buf, 4, order)
+		})
+
+		var errOrder ErrOrderSliceLength
+		assert.ErrorAs(t, err, &errOrder)
+		assert.Equal(t, 4, errOrder.Size)
+		assert.Equal(t, order, errOrder.Order)
+		assert.Zero(t, got)
+	})
+
+	t.Run("it should still read widths the order handles correctly", func(t *testing.T) {
+		got, err := ReadOrderedT[uint16]([]byte{0xDE, 0xAD}, 0, order)
+
+		assert.NoError(t, err, "it should not return an error")
+		assert.Equal(t, uint16(0xDEAD), got)
+	})
+}
+
+func TestOnRead(t *testing.T) {
+	type call struct {
+		kind reflect.Kind
+		size int
+	}
+
+	var calls []call
+	OnRead = func(kind reflect.Kind, size int) {
+		calls = append(calls, call{kind, size})
+	}
+	t.Cleanup(func() { OnRead = nil })
+
+	t.Run("it should fire after each successful read with the kind and size", func(t *testing.T) {
+		calls = nil
+		buf := make([]byte, 8)
+
+		_, _ = ReadOrderedT[uint16](buf, 0, binary.BigEndian)
+		_, _ = ReadOrderedT[float64](buf, 0, nil)
+		_, _ = ReadOrderedT[int8](buf, 7, binary.LittleEndian)
+
+		assert.Equal(t, []call{{reflect.Uint16, 2}, {reflect.Float64, 8}, {reflect.Int8, 1}}, calls)
+	})
+
+	t.Run("it should not fire for failed reads", func(t *testing.T) {
+		calls = nil
+
+		_, err := ReadOrderedT[uint32](make([]byte, 3), 0, nil)
+
+		assert.ErrorIs(t, err, io.EOF)
+		assert.Empty(t, calls)
+	})
+}
+
+func assertKindAndSizeOf[T Numeric](t *testing.T) {
+	t.Helper()
+	typ := reflect.TypeFor[T]()
+
+	kind, size := kindAndSizeOf[T]()
+
+	assert.Equal(t, typ.Kind(), kind, "kind of %v", typ)
+	assert.Equal(t, typ.Bits()/8, size, "size of %v", typ)
+}
+
+func TestKindAndSizeOf(t *testing.T) {
+	t.Run("it should match reflect for the predeclared types", func(t *testing.T) {
+		assertKindAndSizeOf[int8](t)
+		assertKindAndSizeOf[int16](t)
+		assertKindAndSizeOf[int32](t)
+		assertKindAndSizeOf[int64](t)
+		assertKindAndSizeOf[int](t)
+		assertKindAndSizeOf[uint8](t)
+		assertKindAndSizeOf[uint16](t)
+		assertKindAndSizeOf[uint32](t)
+		assertKindAndSizeOf[uint64](t)
+		assertKindAndSizeOf[uint](t)
+		assertKindAndSizeOf[uintptr](t)
+		assertKindAndSizeOf[float32](t)
+		assertKindAndSizeOf[float64](t)
+	})
+
+	t.Run("it should fall back to reflect for named types", func(t *testing.T) {
+		type celsius float32
+		type flags uint16
+		type handle uintptr
+
+		assertKindAndSizeOf[celsius](t)
+		assertKindAndSizeOf[flags](t)
+		assertKindAndSizeOf[handle](t)
+		assertKindAndSizeOf[time.Duration](t)
+	})
+
+	t.Run("it should decode named types identically to their underlying types", func(t *testing.T) {
+		type flags uint16
+		buf := []byte{0xDE, 0xAD}
+
+		named, err := ReadOrderedT[flags](buf, 0, binary.BigEndian)
+		assert.NoError(t, err, "it should not return an error")
+		plain, _ := ReadOrderedT[uint16](buf, 0, binary.BigEndian)
+
+		assert.Equal(t, plain, uint16(named))
+	})
+}
@@ -0,0 +1,119 @@
+package buffergenerics
+
+import (
+	"encoding/binary"
+)
+
+// Reader reads consecutive values from a buffer, tracking a cursor and a default byte order.
+// A Reader can be reused for another buffer with Reset, which makes it suitable for pooling.
+type Reader struct {
+	buffer []byte
+	offset int
+	order  binary.ByteOrder
+	marks  map[string]int
+}
+
+// NewReader returns a Reader positioned at the start of the given buffer, using the specified byte order
+// for all reads. If the byte order is nil, it defaults to binary.NativeEndian.
+func NewReader(buffer []byte, order binary.ByteOrder) *Reader {
+	return &Reader{
+		buffer: buffer,
+		order:  order,
+	}
+}
+
+// Reset reinitializes the Reader to read from the start of the given buffer using the specified byte order,
+// discarding all previous state, including bookmarks. If the byte order is nil, it defaults to binary.NativeEndian.
+func (r *Reader) Reset(buffer []byte, order binary.ByteOrder) {
+	*r = Reader{
+		buffer: buffer,
+		order:  order,
+	}
+}
+
+// Offset returns the position of the cursor.
+func (r *Reader) Offset() int {
+	return r.offset
+}
+
+// Len returns the number of unread bytes.
+func (r *Reader) Len() int {
+	return max(len(r.buffer)-r.offset, 0)
+}
+
+// Order returns the byte order used by the Reader.
+func (r *Reader) Order() binary.ByteOrder {
+	return r.order
+}
+
+// RemainingBytes returns a copy of all unread bytes and advances the cursor to the end of the buffer.
+// See also: RemainingBytesNoCopy.
+func (r *Reader) RemainingBytes() []byte {
+	return Clone(r.RemainingBytesNoCopy())
+}
+
+// RemainingBytesNoCopy is like RemainingBytes, but returns a view of the unread bytes aliasing the buffer rather
+// than a copy, avoiding an allocation. Modifying the buffer afterward modifies the returned bytes and vice versa.
+// The view's capacity is capped at its length, so appending to it never overwrites the buffer.
+func (r *Reader) RemainingBytesNoCopy() []byte {
+	start := min(r.offset, len(r.buffer))
+	r.offset = len(r.buffer)
+
+	return r.buffer[start:len(r.buffer):len(r.buffer)]
+}
+
+// Mark records the position of the cursor as a bookmark with the given name, replacing any previous bookmark
+// of the same name, so that the cursor can later be moved back to it with Return.
+func (r *Reader) Mark(name string) {
+	if r.marks == nil {
+		r.marks = make(map[string]int)
+	}
+
+	r.marks[name] = r.offset
+}
+
+// Return moves the cursor to the bookmark with the given name, as recorded by Mark. The bookmark is kept,
+// so it may be returned to repeatedly. It returns ErrUnknownMark if no bookmark has that name.
+func (r *Reader) Return(name string) error {
+	offset, ok := r.marks[name]
+	if !ok {
+		return NewErrUnknownMark(name)
+	}
+
+	r.offset = offset
+	return nil
+}
+
+// ReadNext reads a value of type T at the cursor of the given Reader and advances the cursor past it.
+// It returns any error encountered during the read operation, in which case the cursor is not advanced.
+// See also: ReadOrderedT.
+func ReadNext[T Numeric](r *Reader) (T, error) {
+	val, err := ReadOrderedT[T](r.buffer, r.offset, r.order)
+	if err != nil {
+		return val, err
+	}
+
+	r.offset += SizeOf[T]()
+	return val, nil
+}
+
+// ReadNextOK reads a value of type T at the cursor of the given Reader and advances the cursor past it.
+// It returns the value and true on success, or the zero value of type T and false without moving the cursor
+// if the read fails, which allows draining a buffer with a simple loop.
+// See also: ReadNext, ReadOrderedTOK.
+func ReadNextOK[T Numeric](r *Reader) (T, bool) {
+	val, err := ReadNext[T](r)
+	return val, err == nil
+}
+
+// ReadBehind reads a value of type T starting bytesBack bytes behind the cursor of the given Reader, without
+// moving the cursor, for example to re-read a back-reference within the current record. If the byte order is nil,
+// the Reader's byte order is used. It returns io.EOF if the position is negative or the value does not fit.
+// See also: ReadOrderedT.
+func ReadBehind[T Numeric](r *Reader, bytesBack int, order binary.ByteOrder) (T, error) {
+	if order == nil {
+		order = r.order
+	}
+
+	return ReadOrderedT[T](r.buffer, r.offset-bytesBack, order)
+}
@@ -0,0 +1,238 @@
+package buffergenerics
+
+import (
+	"encoding/binary"
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/stretchr/testify/assert"
+	"io"
+	"sync"
+	"testing"
+)
+
+func TestReader_ReadNext(t *testing.T) {
+	t.Run("it should read consecutive values and advance the cursor", func(t *testing.T) {
+		order := binary.BigEndian
+		want8, want32 := gofakeit.Uint8(), gofakeit.Float32()
+		buf, _ := AppendOrderedT(nil, want8, order)
+		buf, _ = AppendOrderedT(buf, want32, order)
+		r := NewReader(buf, order)
+
+		got8, err := ReadNext[uint8](r)
+		assert.NoError(t, err, "it should not return an error")
+		assert.Equal(t, want8, got8)
+		assert.Equal(t, 1, r.Offset())
+
+		got32, err := ReadNext[float32](r)
+		assert.NoError(t, err, "it should not return an error")
+		assert.Equal(t, want32, got32)
+		assert.Equal(t, 5, r.Offset())
+		assert.Zero(t, r.Len())
+	})
+
+	t.Run("it should not advance the cursor on error", func(t *testing.T) {
+		r := NewReader([]byte{0xDE, 0xAD}, binary.BigEndian)
+
+		_, err := ReadNext[uint32](r)
+
+		assert.ErrorIs(t, err, io.EOF)
+		assert.Zero(t, r.Offset())
+	})
+}
+
+func TestReader_Reset(t *testing.T) {
+	t.Run("it should reuse a Reader across buffers without leaking state", func(t *testing.T) {
+		r := NewReader([]byte{0x00, 0x01, 0x00, 0x02}, binary.BigEndian)
+		_, _ = ReadNext[uint16](r)
+		_, _ = ReadNext[uint16](r)
+
+		r.Reset([]byte{0x03, 0x00}, binary.LittleEndian)
+
+		assert.Zero(t, r.Offset())
+		assert.Equal(t, 2, r.Len())
+		assert.Equal(t, binary.LittleEndian, r.Order())
+
+		val, err := ReadNext[uint16](r)
+		assert.NoError(t, err, "it should not return an error")
+		assert.Equal(t, uint16(3), val)
+	})
+
+	t.Run("it should work with sync.Pool", func(t *testing.T) {
+		pool := sync.Pool{New: func() any { return new(Reader) }}
+
+		for i := 0; i < 3; i++ {
+			want := gofakeit.Uint32()
+			buf, _ := AppendOrderedT(nil, want, binary.LittleEndian)
+
+			r := pool.Get().(*Reader)
+			r.Reset(buf, binary.LittleEndian)
+			got, err := ReadNext[uint32](r)
+			pool.Put(r)
+
+			assert.NoError(t, err, "it should not return an error")
+			assert.Equal(t, want, got)
+		}
+	})
+}
+
+func TestReader_Mark(t *testing.T) {
+	t.Run("it should return to the marked position", func(t *testing.T) {
+		order := binary.LittleEndian
+		want16, want32 := gofakeit.Uint16(), gofakeit.Uint32()
+		buf, _ := AppendOrderedT([]byte{0xFF}, want16, order)
+		buf, _ = AppendOrderedT(buf, want32, order)
+		r := NewReader(buf, order)
+
+		_, _ = ReadNext[uint8](r)
+		r.Mark("record")
+		_, _ = ReadNext[uint16](r)
+		_, _ = ReadNext[uint32](r)
+		assert.Equal(t, 7, r.Offset())
+
+		err := r.Return("record")
+
+		assert.NoError(t, err, "it should not return an error")
+		assert.Equal(t, 1, r.Offset(), "it should land exactly where marked")
+		got16, _ := ReadNext[uint16](r)
+		assert.Equal(t, want16, got16)
+
+		r.Mark("value")
+		got32, _ := ReadNext[uint32](r)
+		assert.NoError(t, r.Return("value"))
+		again32, _ := ReadNext[uint32](r)
+		assert.Equal(t, want32, got32)
+		assert.Equal(t, got32, again32, "it should keep bookmarks after returning")
+	})
+
+	t.Run("it should replace a bookmark of the same name", func(t *testing.T) {
+		r := NewReader(make([]byte, 8), nil)
+
+		r.Mark("m")
+		_, _ = ReadNext[uint32](r)
+		r.Mark("m")
+		_, _ = ReadNext[uint32](r)
+
+		assert.NoError(t, r.Return("m"))
+		assert.Equal(t, 4, r.Offset())
+	})
+
+	t.Run("it should return ErrUnknownMark for unknown names", func(t *testing.T) {
+		r := NewReader(make([]byte, 8), nil)
+		_, _ = ReadNext[uint16](r)
+
+		err := r.Return("missing")
+
+		var errMark ErrUnknownMark
+		assert.ErrorAs(t, err, &errMark)
+		assert.Equal(t, "missing", errMark.Name)
+		assert.Equal(t, 2, r.Offset(), "it should not move the cursor")
+	})
+
+	t.Run("it should discard bookmarks on Reset", func(t *testing.T) {
+		r := NewReader(make([]byte, 8), nil)
+		r.Mark("m")
+
+		r.Reset(make([]byte, 8), nil)
+
+		assert.Error(t, r.Return("m"))
+	})
+}
+
+func TestReader_RemainingBytes(t *testing.T) {
+	t.Run("it should return a copy of the unread bytes and advance to the end", func(t *testing.T) {
+		buf := []byte{0x00, 0x2A, 0xDE, 0xAD, 0xBE, 0xEF}
+		r := NewReader(buf, binary.BigEndian)
+		got16, _ := ReadNext[uint16](r)
+
+		rest := r.RemainingBytes()
+		buf[2] = 0x00
+
+		assert.Equal(t, uint16(42), got16)
+		assert.Equal(t, []byte{0xDE, 0xAD, 0xBE, 0xEF}, rest)
+		assert.Equal(t, len(buf), r.Offset())
+		assert.Zero(t, r.Len())
+		assert.Empty(t, r.RemainingBytes(), "it should return nothing once consumed")
+	})
+
+	t.Run("it should return a view aliasing the buffer for RemainingBytesNoCopy", func(t *testing.T) {
+		buf := []byte{0x01, 0x02, 0xDE, 0xAD}
+		r := NewReader(buf, binary.LittleEndian)
+		_, _ = ReadNext[uint8](r)
+		_, _ = ReadNext[uint8](r)
+
+		rest := r.RemainingBytesNoCopy()
+		buf[2] = 0xCA
+
+		assert.Equal(t, []byte{0xCA, 0xAD}, rest)
+		assert.Equal(t, len(rest), cap(rest))
+		assert.Equal(t, len(buf), r.Offset())
+	})
+}
+
+func TestReader_ReadNextOK(t *testing.T) {
+	t.Run("it should drain a buffer and stop exactly at the end", func(t *testing.T) {
+		want := []uint32{gofakeit.Uint32(), gofakeit.Uint32(), gofakeit.Uint32()}
+		buf, _ := AppendSliceOrderedT(nil, want, binary.BigEndian)
+		r := NewReader(buf, binary.BigEndian)
+
+		var got []uint32
+		for {
+			v, ok := ReadNextOK[uint32](r)
+			if !ok {
+				break
+			}
+
+			got = append(got, v)
+		}
+
+		assert.Equal(t, want, got)
+		assert.Equal(t, len(buf), r.Offset())
+	})
+
+	t.Run("it should not move the cursor on failure", func(t *testing.T) {
+		r := NewReader(make([]byte, 6), nil)
+		_, _ = ReadNext[uint32](r)
+
+		got, ok := ReadNextOK[uint32](r)
+
+		assert.False(t, ok)
+		assert.Zero(t, got)
+		assert.Equal(t, 4, r.Offset())
+		assert.Equal(t, 2, r.Len())
+	})
+}
+
+func TestReadBehind(t *testing.T) {
+	t.Run("it should read a field behind the cursor without moving it", func(t *testing.T) {
+		want := gofakeit.Uint32()
+		buf, _ := AppendOrderedT([]byte{0xAA}, want, binary.BigEndian)
+		r := NewReader(buf, binary.BigEndian)
+		_, _ = ReadNext[uint8](r)
+		_, _ = ReadNext[uint32](r)
+
+		got, err := ReadBehind[uint32](r, 4, nil)
+
+		assert.NoError(t, err, "it should not return an error")
+		assert.Equal(t, want, got)
+		assert.Equal(t, 5, r.Offset())
+	})
+
+	t.Run("it should use the given order over the Reader's", func(t *testing.T) {
+		r := NewReader([]byte{0x01, 0x02, 0x03, 0x04}, binary.BigEndian)
+		_, _ = ReadNext[uint32](r)
+
+		got, err := ReadBehind[uint16](r, 4, binary.LittleEndian)
+
+		assert.NoError(t, err, "it should not return an error")
+		assert.Equal(t, uint16(0x0201), got)
+	})
+
+	t.Run("it should return an EOF error if the position is negative", func(t *testing.T) {
+		r := NewReader(make([]byte, 8), nil)
+		_, _ = ReadNext[uint16](r)
+
+		_, err := ReadBehind[uint32](r, 4, nil)
+
+		assert.ErrorIs(t, err, io.EOF)
+		assert.Equal(t, 2, r.Offset())
+	})
+}
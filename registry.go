@@ -0,0 +1,57 @@
+package buffergenerics
+
+import (
+	"encoding/binary"
+	"fmt"
+	"reflect"
+	"slices"
+	"sync"
+)
+
+// Decoder decodes a value of a registered type from the given buffer starting at the specified offset,
+// using the specified byte order. It returns the decoded value and any error encountered.
+type Decoder func(buffer []byte, offset int, order binary.ByteOrder) (any, error)
+
+var (
+	decodersMu sync.RWMutex
+	decoders   = map[reflect.Type]Decoder{}
+)
+
+// RegisterDecoder registers fn as the decoder for typ, for use by ReadRegistered, replacing any decoder previously
+// registered for typ. It is intended for application-specific fixed layouts that ReadOrderedT cannot handle.
+// It panics if typ or fn is nil, or if typ is of a kind already handled by ReadOrderedT.
+// It is safe for concurrent use.
+func RegisterDecoder(typ reflect.Type, fn Decoder) {
+	if typ == nil || fn == nil {
+		panic("buffergenerics: RegisterDecoder called with nil type or decoder")
+	}
+
+	if slices.Contains(supportedKinds, typ.Kind()) {
+		panic(fmt.Sprintf("buffergenerics: RegisterDecoder called for %v, which has built-in kind %v", typ, typ.Kind()))
+	}
+
+	decodersMu.Lock()
+	defer decodersMu.Unlock()
+
+	decoders[typ] = fn
+}
+
+// ReadRegistered reads a value of type typ from the given buffer starting at the specified offset,
+// using the decoder registered for typ by RegisterDecoder and the specified byte order.
+// If the byte order is nil, it defaults to binary.NativeEndian. It returns the decoded value and any error returned
+// by the decoder, or ErrUnregisteredType if no decoder is registered for typ.
+func ReadRegistered(buffer []byte, offset int, typ reflect.Type, order binary.ByteOrder) (any, error) {
+	if order == nil {
+		order = binary.ByteOrder(binary.NativeEndian)
+	}
+
+	decodersMu.RLock()
+	fn, ok := decoders[typ]
+	decodersMu.RUnlock()
+
+	if !ok {
+		return nil, NewErrUnregisteredType(typ)
+	}
+
+	return fn(buffer, offset, order)
+}
@@ -0,0 +1,64 @@
+package buffergenerics
+
+import (
+	"encoding/binary"
+	"github.com/stretchr/testify/assert"
+	"io"
+	"reflect"
+	"testing"
+)
+
+type testRGB struct {
+	R, G, B uint8
+}
+
+func decodeTestRGB(buffer []byte, offset int, _ binary.ByteOrder) (any, error) {
+	if offset < 0 || offset > len(buffer)-3 {
+		return nil, io.EOF
+	}
+
+	return testRGB{R: buffer[offset], G: buffer[offset+1], B: buffer[offset+2]}, nil
+}
+
+func TestReadRegistered(t *testing.T) {
+	RegisterDecoder(reflect.TypeFor[testRGB](), decodeTestRGB)
+
+	t.Run("it should decode a registered RGB color", func(t *testing.T) {
+		buf := []byte{0x00, 0xFF, 0x80, 0x10}
+
+		got, err := ReadRegistered(buf, 1, reflect.TypeFor[testRGB](), binary.BigEndian)
+
+		assert.NoError(t, err, "it should not return an error")
+		assert.Equal(t, testRGB{R: 0xFF, G: 0x80, B: 0x10}, got)
+	})
+
+	t.Run("it should return the decoder's error", func(t *testing.T) {
+		_, err := ReadRegistered([]byte{0xFF, 0x80}, 0, reflect.TypeFor[testRGB](), nil)
+
+		assert.ErrorIs(t, err, io.EOF)
+	})
+
+	t.Run("it should return ErrUnregisteredType for unregistered types", func(t *testing.T) {
+		type unregistered struct{}
+		typ := reflect.TypeFor[unregistered]()
+
+		_, err := ReadRegistered(make([]byte, 8), 0, typ, nil)
+
+		var errType ErrUnregisteredType
+		assert.ErrorAs(t, err, &errType)
+		assert.Equal(t, typ, errType.Type)
+	})
+}
+
+func TestRegisterDecoder(t *testing.T) {
+	t.Run("it should panic for nil arguments", func(t *testing.T) {
+		assert.Panics(t, func() { RegisterDecoder(nil, decodeTestRGB) })
+		assert.Panics(t, func() { RegisterDecoder(reflect.TypeFor[testRGB](), nil) })
+	})
+
+	t.Run("it should panic for kinds handled by ReadOrderedT", func(t *testing.T) {
+		type celsius float32
+
+		assert.Panics(t, func() { RegisterDecoder(reflect.TypeFor[celsius](), decodeTestRGB) })
+	})
+}
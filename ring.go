@@ -0,0 +1,58 @@
+package buffergenerics
+
+import (
+	"encoding/binary"
+	"io"
+)
+
+// ReadRingOrderedT reads a value of type T from the given circular buffer starting at the specified offset,
+// using the specified byte order. If the byte order is nil, it defaults to binary.NativeEndian. The offset is taken
+// modulo the length of the buffer, and a value that would pass the end continues at the beginning; its bytes are
+// assembled across the seam into a scratch array before decoding. It returns the read value and io.EOF if the offset
+// is negative or the buffer is smaller than the value.
+// See also: ReadOrderedT, WriteRingOrderedT.
+func ReadRingOrderedT[T Numeric](buffer []byte, offset int, order binary.ByteOrder) (T, error) {
+	size := SizeOf[T]()
+	if offset < 0 || len(buffer) < size {
+		return *new(T), io.EOF
+	}
+
+	offset %= len(buffer)
+	if offset <= len(buffer)-size {
+		return ReadOrderedT[T](buffer, offset, order)
+	}
+
+	var scratch [8]byte
+	n := copy(scratch[:size], buffer[offset:])
+	copy(scratch[n:size], buffer)
+
+	return ReadOrderedT[T](scratch[:size], 0, order)
+}
+
+// WriteRingOrderedT writes a value of type T to the given circular buffer starting at the specified offset,
+// using the specified byte order. If the byte order is nil, it defaults to binary.NativeEndian. As with
+// ReadRingOrderedT, the offset is taken modulo the length of the buffer, and a value that would pass the end
+// continues at the beginning. It returns io.EOF if the offset is negative or the buffer is smaller than the value,
+// in which case the buffer is not modified.
+// See also: WriteOrderedT, ReadRingOrderedT.
+func WriteRingOrderedT[T Numeric](buffer []byte, offset int, value T, order binary.ByteOrder) error {
+	size := SizeOf[T]()
+	if offset < 0 || len(buffer) < size {
+		return io.EOF
+	}
+
+	offset %= len(buffer)
+	if offset <= len(buffer)-size {
+		return WriteOrderedT[T](buffer, offset, value, order)
+	}
+
+	var scratch [8]byte
+	if err := WriteOrderedT[T](scratch[:size], 0, value, order); err != nil {
+		return err
+	}
+
+	n := copy(buffer[offset:], scratch[:size])
+	copy(buffer, scratch[n:size])
+
+	return nil
+}
@@ -0,0 +1,98 @@
+package buffergenerics
+
+import (
+	"encoding/binary"
+	"github.com/stretchr/testify/assert"
+	"io"
+	"testing"
+)
+
+func TestReadRingOrderedT(t *testing.T) {
+	ring := []byte{0x05, 0x06, 0x07, 0x08, 0x09, 0x0A, 0x01, 0x02, 0x03, 0x04}
+
+	t.Run("it should read entirely before the seam", func(t *testing.T) {
+		got, err := ReadRingOrderedT[uint32](ring, 6, binary.BigEndian)
+
+		assert.NoError(t, err, "it should not return an error")
+		assert.Equal(t, uint32(0x01020304), got)
+	})
+
+	t.Run("it should read straddling the seam", func(t *testing.T) {
+		got, err := ReadRingOrderedT[uint32](ring, 8, binary.BigEndian)
+
+		assert.NoError(t, err, "it should not return an error")
+		assert.Equal(t, uint32(0x03040506), got)
+
+		got64, err := ReadRingOrderedT[uint64](ring, 9, binary.LittleEndian)
+
+		assert.NoError(t, err, "it should not return an error")
+		assert.Equal(t, uint64(0x010A090807060504), got64)
+	})
+
+	t.Run("it should read entirely after wrapping", func(t *testing.T) {
+		got, err := ReadRingOrderedT[uint16](ring, 12, binary.BigEndian)
+
+		assert.NoError(t, err, "it should not return an error")
+		assert.Equal(t, uint16(0x0708), got)
+	})
+
+	t.Run("it should return an EOF error for negative offsets and small buffers", func(t *testing.T) {
+		_, err := ReadRingOrderedT[uint16](ring, -1, nil)
+		assert.ErrorIs(t, err, io.EOF)
+
+		_, err = ReadRingOrderedT[uint64](ring[:7], 0, nil)
+		assert.ErrorIs(t, err, io.EOF)
+
+		_, err = ReadRingOrderedT[uint8](nil, 0, nil)
+		assert.ErrorIs(t, err, io.EOF)
+	})
+}
+
+func TestWriteRingOrderedT(t *testing.T) {
+	t.Run("it should write an element straddling the seam", func(t *testing.T) {
+		ring := make([]byte, 6)
+
+		err := WriteRingOrderedT[uint32](ring, 4, 0xDEADBEEF, binary.BigEndian)
+
+		assert.NoError(t, err, "it should not return an error")
+		assert.Equal(t, []byte{0xBE, 0xEF, 0x00, 0x00, 0xDE, 0xAD}, ring)
+
+		got, err := ReadRingOrderedT[uint32](ring, 4, binary.BigEndian)
+		assert.NoError(t, err, "it should not return an error")
+		assert.Equal(t, uint32(0xDEADBEEF), got)
+	})
+
+	t.Run("it should write an element after wrapping fully", func(t *testing.T) {
+		ring := make([]byte, 6)
+
+		err := WriteRingOrderedT[uint16](ring, 13, 0xCAFE, binary.LittleEndian)
+
+		assert.NoError(t, err, "it should not return an error")
+		assert.Equal(t, []byte{0x00, 0xFE, 0xCA, 0x00, 0x00, 0x00}, ring)
+
+		got, _ := ReadRingOrderedT[uint16](ring, 1, binary.LittleEndian)
+		assert.Equal(t, uint16(0xCAFE), got)
+	})
+
+	t.Run("it should round-trip every position across the seam", func(t *testing.T) {
+		ring := make([]byte, 11)
+
+		for offset := range 2 * len(ring) {
+			want := float64(offset) + 0.5
+			assert.NoError(t, WriteRingOrderedT(ring, offset, want, nil))
+
+			got, err := ReadRingOrderedT[float64](ring, offset, nil)
+
+			assert.NoError(t, err, "it should not return an error")
+			assert.Equal(t, want, got, "offset %d", offset)
+		}
+	})
+
+	t.Run("it should return an EOF error without modifying the buffer", func(t *testing.T) {
+		ring := []byte{0xAA, 0xBB, 0xCC}
+
+		assert.ErrorIs(t, WriteRingOrderedT[uint32](ring, 0, 1, nil), io.EOF)
+		assert.ErrorIs(t, WriteRingOrderedT[uint8](ring, -1, 1, nil), io.EOF)
+		assert.Equal(t, []byte{0xAA, 0xBB, 0xCC}, ring)
+	})
+}
@@ -0,0 +1,36 @@
+package buffergenerics
+
+import (
+	"io"
+)
+
+// ReadRLE decodes pairs run-length encoded (count, value) byte pairs from the given buffer starting at the specified
+// offset, expanding each into count copies of value. To guard against malicious counts, the decoded length is
+// computed before anything is allocated and must not exceed maxOut.
+// It returns the decoded bytes, io.EOF if the buffer does not hold pairs pairs, and ErrRLEExpansionLimit if the
+// decoded length would exceed maxOut.
+func ReadRLE(buffer []byte, offset, pairs, maxOut int) ([]byte, error) {
+	if offset < 0 || pairs < 0 || offset > len(buffer) || pairs > (len(buffer)-offset)/2 {
+		return nil, io.EOF
+	}
+
+	encoded := buffer[offset : offset+pairs*2]
+
+	total := 0
+	for i := 0; i < len(encoded); i += 2 {
+		total += int(encoded[i])
+	}
+
+	if total > maxOut {
+		return nil, NewErrRLEExpansionLimit(total, maxOut)
+	}
+
+	decoded := make([]byte, 0, total)
+	for i := 0; i < len(encoded); i += 2 {
+		for range encoded[i] {
+			decoded = append(decoded, encoded[i+1])
+		}
+	}
+
+	return decoded, nil
+}
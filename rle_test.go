@@ -0,0 +1,45 @@
+package buffergenerics
+
+import (
+	"github.com/stretchr/testify/assert"
+	"io"
+	"testing"
+)
+
+func TestReadRLE(t *testing.T) {
+	t.Run("it should expand each pair", func(t *testing.T) {
+		buf := []byte{0xFF, 3, 'a', 0, 'x', 1, 'b', 2, 0x00}
+
+		got, err := ReadRLE(buf, 1, 4, 16)
+
+		assert.NoError(t, err, "it should not return an error")
+		assert.Equal(t, []byte{'a', 'a', 'a', 'b', 0x00, 0x00}, got)
+	})
+
+	t.Run("it should allow an expansion exactly at the limit", func(t *testing.T) {
+		got, err := ReadRLE([]byte{255, 0x7F, 1, 0x7F}, 0, 2, 256)
+
+		assert.NoError(t, err, "it should not return an error")
+		assert.Len(t, got, 256)
+	})
+
+	t.Run("it should return ErrRLEExpansionLimit when the limit is exceeded", func(t *testing.T) {
+		buf := []byte{255, 0x00, 255, 0x00, 255, 0x00}
+
+		got, err := ReadRLE(buf, 0, 3, 512)
+
+		var errLimit ErrRLEExpansionLimit
+		assert.ErrorAs(t, err, &errLimit)
+		assert.Equal(t, 765, errLimit.Length)
+		assert.Equal(t, 512, errLimit.Limit)
+		assert.Nil(t, got)
+	})
+
+	t.Run("it should return an EOF error for truncated pairs", func(t *testing.T) {
+		for _, tc := range []struct{ offset, pairs int }{{0, 3}, {2, 2}, {-1, 1}, {0, -1}, {6, 0}} {
+			_, err := ReadRLE([]byte{1, 'a', 1, 'b', 1}, tc.offset, tc.pairs, 16)
+
+			assert.ErrorIs(t, err, io.EOF, "offset %d, pairs %d", tc.offset, tc.pairs)
+		}
+	})
+}
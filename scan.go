@@ -0,0 +1,109 @@
+package buffergenerics
+
+import (
+	"encoding/binary"
+	"errors"
+	"reflect"
+)
+
+// Scan reads consecutive values from the given buffer starting at the specified offset into each of dsts in turn,
+// using the specified byte order. If the byte order is nil, it defaults to binary.NativeEndian. Each of dsts must be
+// a non-nil pointer to a fixed-width integer, float, or bool (including named types thereof); the width read is
+// determined by the pointed-to type, with int, uint, and uintptr read at their width on the host. It returns the total number of bytes consumed. If an argument is not
+// supported, it returns ErrScanArg naming its index; if a read fails, it returns the error and the bytes consumed
+// by the preceding arguments.
+func Scan(buffer []byte, offset int, order binary.ByteOrder, dsts ...any) (int, error) {
+	n := 0
+
+	for i, dst := range dsts {
+		ptr := reflect.ValueOf(dst)
+		if ptr.Kind() != reflect.Pointer || ptr.IsNil() {
+			return n, NewErrScanArg(i, reflect.TypeOf(dst))
+		}
+
+		size, err := scanValue(buffer, offset+n, order, ptr.Elem())
+		if err != nil {
+			if errors.As(err, &ErrUnknownKind{}) {
+				return n, NewErrScanArg(i, ptr.Type())
+			}
+
+			return n, err
+		}
+
+		n += size
+	}
+
+	return n, nil
+}
+
+// scanValue reads a value of the kind of elem from the given buffer at the specified offset and stores it in elem.
+// It returns the number of bytes read, or ErrUnknownKind if the kind of elem is not supported.
+func scanValue(buffer []byte, offset int, order binary.ByteOrder, elem reflect.Value) (int, error) {
+	switch kind := elem.Kind(); kind {
+	case reflect.Bool:
+		val, err := ReadBool(buffer, offset)
+		if err != nil {
+			return 0, err
+		}
+		elem.SetBool(val)
+		return 1, nil
+	case reflect.Int8:
+		return scanInt[int8](buffer, offset, order, elem)
+	case reflect.Int16:
+		return scanInt[int16](buffer, offset, order, elem)
+	case reflect.Int32:
+		return scanInt[int32](buffer, offset, order, elem)
+	case reflect.Int64:
+		return scanInt[int64](buffer, offset, order, elem)
+	case reflect.Int:
+		return scanInt[int](buffer, offset, order, elem)
+	case reflect.Uint8:
+		return scanUint[uint8](buffer, offset, order, elem)
+	case reflect.Uint16:
+		return scanUint[uint16](buffer, offset, order, elem)
+	case reflect.Uint32:
+		return scanUint[uint32](buffer, offset, order, elem)
+	case reflect.Uint64:
+		return scanUint[uint64](buffer, offset, order, elem)
+	case reflect.Uint:
+		return scanUint[uint](buffer, offset, order, elem)
+	case reflect.Uintptr:
+		return scanUint[uintptr](buffer, offset, order, elem)
+	case reflect.Float32:
+		return scanFloat[float32](buffer, offset, order, elem)
+	case reflect.Float64:
+		return scanFloat[float64](buffer, offset, order, elem)
+	default:
+		return 0, NewErrUnknownKind(kind)
+	}
+}
+
+func scanInt[T int8 | int16 | int32 | int64 | int](buffer []byte, offset int, order binary.ByteOrder, elem reflect.Value) (int, error) {
+	val, err := ReadOrderedT[T](buffer, offset, order)
+	if err != nil {
+		return 0, err
+	}
+
+	elem.SetInt(int64(val))
+	return SizeOf[T](), nil
+}
+
+func scanUint[T uint8 | uint16 | uint32 | uint64 | uint | uintptr](buffer []byte, offset int, order binary.ByteOrder, elem reflect.Value) (int, error) {
+	val, err := ReadOrderedT[T](buffer, offset, order)
+	if err != nil {
+		return 0, err
+	}
+
+	elem.SetUint(uint64(val))
+	return SizeOf[T](), nil
+}
+
+func scanFloat[T float32 | float64](buffer []byte, offset int, order binary.ByteOrder, elem reflect.Value) (int, error) {
+	val, err := ReadOrderedT[T](buffer, offset, order)
+	if err != nil {
+		return 0, err
+	}
+
+	elem.SetFloat(float64(val))
+	return SizeOf[T](), nil
+}
@@ -0,0 +1,107 @@
+package buffergenerics
+
+import (
+	"encoding/binary"
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/stretchr/testify/assert"
+	"io"
+	"reflect"
+	"testing"
+)
+
+func TestScan(t *testing.T) {
+	t.Run("it should read a mix of fields sequentially", func(t *testing.T) {
+		order := binary.BigEndian
+		wantU16, wantI32, wantF64 := gofakeit.Uint16(), gofakeit.Int32(), gofakeit.Float64()
+		buf := []byte{0xAA}
+		buf, _ = AppendOrderedT(buf, wantU16, order)
+		buf, _ = AppendOrderedT(buf, wantI32, order)
+		buf = append(buf, 0x01)
+		buf, _ = AppendOrderedT(buf, wantF64, order)
+
+		var u16 uint16
+		var i32 int32
+		var flag bool
+		var f64 float64
+		n, err := Scan(buf, 1, order, &u16, &i32, &flag, &f64)
+
+		assert.NoError(t, err, "it should not return an error")
+		assert.Equal(t, wantU16, u16)
+		assert.Equal(t, wantI32, i32)
+		assert.True(t, flag)
+		assert.Equal(t, wantF64, f64)
+		assert.Equal(t, 2+4+1+8, n)
+	})
+
+	t.Run("it should support named types", func(t *testing.T) {
+		type myType int16
+		buf := []byte{0xFF, 0xFE}
+
+		var v myType
+		_, err := Scan(buf, 0, binary.BigEndian, &v)
+
+		assert.NoError(t, err, "it should not return an error")
+		assert.Equal(t, myType(-2), v)
+	})
+
+	t.Run("it should read platform-dependent integers at their host width", func(t *testing.T) {
+		order := binary.BigEndian
+		wantInt, wantUint, wantPtr := int(gofakeit.Int32()), uint(gofakeit.Uint32()), uintptr(gofakeit.Uint32())
+		buf, _ := AppendOrderedT(nil, wantInt, order)
+		buf, _ = AppendOrderedT(buf, wantUint, order)
+		buf, _ = AppendOrderedT(buf, wantPtr, order)
+
+		var gotInt int
+		var gotUint uint
+		var gotPtr uintptr
+		n, err := Scan(buf, 0, order, &gotInt, &gotUint, &gotPtr)
+
+		assert.NoError(t, err, "it should not return an error")
+		assert.Equal(t, wantInt, gotInt)
+		assert.Equal(t, wantUint, gotUint)
+		assert.Equal(t, wantPtr, gotPtr)
+		assert.Equal(t, SizeOf[int]()+SizeOf[uint]()+SizeOf[uintptr](), n)
+	})
+
+	t.Run("it should return ErrScanArg naming a non-pointer argument", func(t *testing.T) {
+		buf := make([]byte, 16)
+
+		var u16 uint16
+		var i32 int32
+		n, err := Scan(buf, 0, binary.BigEndian, &u16, i32)
+
+		var errArg ErrScanArg
+		assert.ErrorAs(t, err, &errArg)
+		assert.Equal(t, 1, errArg.Index)
+		assert.Equal(t, reflect.TypeFor[int32](), errArg.Type)
+		assert.Equal(t, 2, n)
+	})
+
+	t.Run("it should return ErrScanArg for pointers to unsupported types", func(t *testing.T) {
+		var s string
+		_, err := Scan(make([]byte, 16), 0, binary.BigEndian, &s)
+
+		var errArg ErrScanArg
+		assert.ErrorAs(t, err, &errArg)
+		assert.Equal(t, 0, errArg.Index)
+		assert.Equal(t, reflect.TypeFor[*string](), errArg.Type)
+	})
+
+	t.Run("it should return ErrScanArg for nil pointers", func(t *testing.T) {
+		var p *uint32
+		_, err := Scan(make([]byte, 16), 0, binary.BigEndian, p, nil)
+
+		assert.ErrorAs(t, err, &ErrScanArg{})
+	})
+
+	t.Run("it should return an EOF error and the bytes consumed for short buffers", func(t *testing.T) {
+		buf := make([]byte, 5)
+
+		var a uint32
+		var b uint32
+		n, err := Scan(buf, 0, binary.BigEndian, &a, &b)
+
+		assert.ErrorIs(t, err, io.EOF)
+		assert.Equal(t, 4, n)
+	})
+}
@@ -0,0 +1,41 @@
+package buffergenerics
+
+import (
+	"encoding/binary"
+)
+
+// readAt reads a value of type T at each of offsets in the given buffer, using the specified byte order.
+// It returns ErrOffsetIndex wrapping the read error for the first offset that cannot be read.
+func readAt[T Numeric](buffer []byte, offsets []int, order binary.ByteOrder) ([]T, error) {
+	values := make([]T, len(offsets))
+
+	for i, offset := range offsets {
+		val, err := ReadOrderedT[T](buffer, offset, order)
+		if err != nil {
+			return nil, NewErrOffsetIndex(i, offset, err)
+		}
+
+		values[i] = val
+	}
+
+	return values, nil
+}
+
+// ReadUint64sAt reads a uint64 at each of offsets in the given buffer, using the specified byte order.
+// If the byte order is nil, it defaults to binary.NativeEndian. This suits index and table structures pointing
+// at scattered values. It returns the values in the order of offsets, or ErrOffsetIndex, which wraps io.EOF,
+// naming the index of the first offset that is out of range.
+// See also: ReadOrderedT.
+func ReadUint64sAt(buffer []byte, offsets []int, order binary.ByteOrder) ([]uint64, error) {
+	return readAt[uint64](buffer, offsets, order)
+}
+
+// ReadUint32sAt is like ReadUint64sAt, but reads a uint32 at each of offsets.
+func ReadUint32sAt(buffer []byte, offsets []int, order binary.ByteOrder) ([]uint32, error) {
+	return readAt[uint32](buffer, offsets, order)
+}
+
+// ReadUint16sAt is like ReadUint64sAt, but reads a uint16 at each of offsets.
+func ReadUint16sAt(buffer []byte, offsets []int, order binary.ByteOrder) ([]uint16, error) {
+	return readAt[uint16](buffer, offsets, order)
+}
@@ -0,0 +1,79 @@
+package buffergenerics
+
+import (
+	"encoding/binary"
+	"github.com/stretchr/testify/assert"
+	"io"
+	"testing"
+)
+
+func TestReadUint64sAt(t *testing.T) {
+	t.Run("it should read a value at each offset", func(t *testing.T) {
+		buf := make([]byte, 32)
+		binary.BigEndian.PutUint64(buf[0:], 0x1111)
+		binary.BigEndian.PutUint64(buf[24:], 0x2222)
+		binary.BigEndian.PutUint64(buf[9:], 0x3333)
+
+		got, err := ReadUint64sAt(buf, []int{24, 0, 9, 24}, binary.BigEndian)
+
+		assert.NoError(t, err, "it should not return an error")
+		assert.Equal(t, []uint64{0x2222, 0x1111, 0x3333, 0x2222}, got)
+	})
+
+	t.Run("it should name the first out-of-range offset index", func(t *testing.T) {
+		got, err := ReadUint64sAt(make([]byte, 16), []int{0, 8, 9, -1}, nil)
+
+		var errIndex ErrOffsetIndex
+		assert.ErrorAs(t, err, &errIndex)
+		assert.Equal(t, 2, errIndex.Index)
+		assert.Equal(t, 9, errIndex.Offset)
+		assert.ErrorIs(t, err, io.EOF)
+		assert.EqualError(t, err, "offsets[2] = 9: EOF")
+		assert.Nil(t, got)
+	})
+
+	t.Run("it should return an empty slice for no offsets", func(t *testing.T) {
+		got, err := ReadUint64sAt(nil, nil, nil)
+
+		assert.NoError(t, err, "it should not return an error")
+		assert.Empty(t, got)
+	})
+}
+
+func TestReadUint32sAt(t *testing.T) {
+	t.Run("it should read a value at each offset", func(t *testing.T) {
+		buf := []byte{0x01, 0x00, 0x00, 0x00, 0x02, 0x00, 0x00, 0x00}
+
+		got, err := ReadUint32sAt(buf, []int{4, 0}, binary.LittleEndian)
+
+		assert.NoError(t, err, "it should not return an error")
+		assert.Equal(t, []uint32{2, 1}, got)
+	})
+
+	t.Run("it should name the first out-of-range offset index", func(t *testing.T) {
+		_, err := ReadUint32sAt(make([]byte, 8), []int{5}, nil)
+
+		var errIndex ErrOffsetIndex
+		assert.ErrorAs(t, err, &errIndex)
+		assert.Equal(t, 0, errIndex.Index)
+	})
+}
+
+func TestReadUint16sAt(t *testing.T) {
+	t.Run("it should read a value at each offset", func(t *testing.T) {
+		buf := []byte{0xDE, 0xAD, 0xBE, 0xEF}
+
+		got, err := ReadUint16sAt(buf, []int{1, 2, 0}, binary.BigEndian)
+
+		assert.NoError(t, err, "it should not return an error")
+		assert.Equal(t, []uint16{0xADBE, 0xBEEF, 0xDEAD}, got)
+	})
+
+	t.Run("it should name the first out-of-range offset index", func(t *testing.T) {
+		_, err := ReadUint16sAt(make([]byte, 4), []int{0, 2, 3}, nil)
+
+		var errIndex ErrOffsetIndex
+		assert.ErrorAs(t, err, &errIndex)
+		assert.Equal(t, 2, errIndex.Index)
+	})
+}
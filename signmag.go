@@ -0,0 +1,26 @@
+package buffergenerics
+
+import (
+	"encoding/binary"
+	"golang.org/x/exp/constraints"
+)
+
+// ReadSignMagnitudeOrdered reads a sign-magnitude integer of type T from the given buffer starting at the specified
+// offset, using the specified byte order. If the byte order is nil, it defaults to binary.NativeEndian.
+// The top bit is taken as the sign and the remaining bits as the magnitude, rather than reinterpreting the bits
+// as two's complement; negative zero is returned as zero.
+// It returns the read value and any error encountered during the read operation.
+// See also: ReadOrderedT.
+func ReadSignMagnitudeOrdered[T constraints.Signed](buffer []byte, offset int, order binary.ByteOrder) (T, error) {
+	val, err := ReadOrderedT[T](buffer, offset, order)
+	if err != nil {
+		return val, err
+	}
+
+	if val >= 0 {
+		return val, nil
+	}
+
+	magnitude := val & T(^uint64(0)>>(65-SizeOf[T]()*8))
+	return -magnitude, nil
+}
@@ -0,0 +1,55 @@
+package buffergenerics
+
+import (
+	"encoding/binary"
+	"github.com/stretchr/testify/assert"
+	"io"
+	"testing"
+)
+
+func TestReadSignMagnitudeOrdered(t *testing.T) {
+	t.Run("it should differ from two's complement for negative values", func(t *testing.T) {
+		buf := []byte{0x80, 0x05}
+
+		sm, err := ReadSignMagnitudeOrdered[int16](buf, 0, binary.BigEndian)
+		assert.NoError(t, err, "it should not return an error")
+		tc, _ := ReadOrderedT[int16](buf, 0, binary.BigEndian)
+
+		assert.Equal(t, int16(-5), sm)
+		assert.Equal(t, int16(-32763), tc)
+	})
+
+	t.Run("it should agree with two's complement for positive values", func(t *testing.T) {
+		buf := []byte{0x12, 0x34, 0x56, 0x78}
+
+		sm, err := ReadSignMagnitudeOrdered[int32](buf, 0, binary.BigEndian)
+		assert.NoError(t, err, "it should not return an error")
+		tc, _ := ReadOrderedT[int32](buf, 0, binary.BigEndian)
+
+		assert.Equal(t, int32(0x12345678), sm)
+		assert.Equal(t, tc, sm)
+	})
+
+	t.Run("it should handle each width", func(t *testing.T) {
+		i8, _ := ReadSignMagnitudeOrdered[int8]([]byte{0xFF}, 0, nil)
+		i32, _ := ReadSignMagnitudeOrdered[int32]([]byte{0x01, 0x00, 0x00, 0x80}, 0, binary.LittleEndian)
+		i64, _ := ReadSignMagnitudeOrdered[int64]([]byte{0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF}, 0, nil)
+
+		assert.Equal(t, int8(-127), i8)
+		assert.Equal(t, int32(-1), i32)
+		assert.Equal(t, int64(-(1<<63 - 1)), i64)
+	})
+
+	t.Run("it should read negative zero as zero", func(t *testing.T) {
+		got, err := ReadSignMagnitudeOrdered[int16]([]byte{0x80, 0x00}, 0, binary.BigEndian)
+
+		assert.NoError(t, err, "it should not return an error")
+		assert.Zero(t, got)
+	})
+
+	t.Run("it should return an EOF error for short buffers", func(t *testing.T) {
+		_, err := ReadSignMagnitudeOrdered[int32]([]byte{0x80, 0x00}, 0, binary.BigEndian)
+
+		assert.ErrorIs(t, err, io.EOF)
+	})
+}
@@ -0,0 +1,188 @@
+package buffergenerics
+
+import (
+	"encoding/binary"
+	"io"
+	"math"
+	"slices"
+)
+
+// ReadArrayOrderedT fills dst with consecutive values of type T read from the given buffer starting at the
+// specified offset, using the specified byte order. If the byte order is nil, it defaults to binary.NativeEndian.
+// Unlike a partial read, the whole of dst must fit: if the buffer holds fewer than len(dst) elements from
+// offset onward, io.EOF is returned and dst is left untouched. This is suited to fixed geometry such as
+// a 4x4 matrix backed by a [16]T array.
+func ReadArrayOrderedT[T Numeric](buffer []byte, offset int, dst []T, order binary.ByteOrder) error {
+	size := SizeOf[T]()
+
+	if offset < 0 || len(dst) > (len(buffer)-offset)/size {
+		return io.EOF
+	}
+
+	for i := range dst {
+		val, err := ReadOrderedT[T](buffer, offset+i*size, order)
+		if err != nil {
+			return err
+		}
+
+		dst[i] = val
+	}
+
+	return nil
+}
+
+// extentOf returns the number of bytes spanned by count elements of the given size placed stride bytes apart.
+// It returns ErrCountOverflow, rather than overflowing, if the extent does not fit in an int; this guards
+// the bounds checks of the slice readers against corrupt or malicious counts.
+func extentOf(count, size, stride int) (int, error) {
+	if count <= 0 {
+		return 0, nil
+	}
+
+	if count-1 > (math.MaxInt-size)/stride {
+		return 0, NewErrCountOverflow(count, size)
+	}
+
+	return (count-1)*stride + size, nil
+}
+
+// ReadSliceStrideOrderedT reads count values of type T from the given buffer, where element i is located at
+// offset + i*stride, using the specified byte order. If the byte order is nil, it defaults to binary.NativeEndian.
+// This allows picking a single column out of an array of fixed-size records without copying the records.
+// It returns ErrInvalidStride if stride is smaller than SizeOf[T](), ErrCountOverflow if the elements would
+// span more than the maximum int bytes, and io.EOF if any element lies outside the buffer.
+func ReadSliceStrideOrderedT[T Numeric](buffer []byte, offset, count, stride int, order binary.ByteOrder) ([]T, error) {
+	size := SizeOf[T]()
+
+	if stride < size {
+		return nil, NewErrInvalidStride(stride, size)
+	}
+
+	if offset < 0 || count < 0 {
+		return nil, io.EOF
+	}
+
+	extent, err := extentOf(count, size, stride)
+	if err != nil {
+		return nil, err
+	}
+
+	if extent > len(buffer)-offset {
+		return nil, io.EOF
+	}
+
+	values := make([]T, count)
+	for i := range values {
+		val, err := ReadOrderedT[T](buffer, offset+i*stride, order)
+		if err != nil {
+			return nil, err
+		}
+
+		values[i] = val
+	}
+
+	return values, nil
+}
+
+// ReadSliceOrderedT reads count consecutive values of type T from the given buffer starting at the specified offset,
+// using the specified byte order. If the byte order is nil, it defaults to binary.NativeEndian.
+// It returns the read values and any error encountered during the read operation, including io.EOF
+// if the buffer cannot hold count elements and ErrCountOverflow if count is too large to be represented in bytes.
+// See also: ReadOrderedT.
+func ReadSliceOrderedT[T Numeric](buffer []byte, offset, count int, order binary.ByteOrder) ([]T, error) {
+	return ReadSliceStrideOrderedT[T](buffer, offset, count, SizeOf[T](), order)
+}
+
+// ReadN reads n consecutive values of type T from the given buffer starting at the specified offset,
+// using the specified byte order. If the byte order is nil, it defaults to binary.NativeEndian, as with ReadOrderedT.
+// It is shorthand for ReadSliceOrderedT and returns the same values and errors.
+func ReadN[T Numeric](buffer []byte, offset, n int, order binary.ByteOrder) ([]T, error) {
+	return ReadSliceOrderedT[T](buffer, offset, n, order)
+}
+
+// ReadSliceSpanOrderedT reads count consecutive values of type T from the given buffer starting at the specified
+// offset, using the specified byte order. If the byte order is nil, it defaults to binary.NativeEndian.
+// In addition to the read values, it returns span, a non-copying view of exactly the bytes the values occupied,
+// which is convenient for computing a checksum over the region. The capacity of span is limited to its length
+// so that appending to it cannot overwrite the rest of the buffer.
+// See also: ReadSliceOrderedT.
+func ReadSliceSpanOrderedT[T Numeric](buffer []byte, offset, count int, order binary.ByteOrder) (values []T, span []byte, err error) {
+	values, err = ReadSliceOrderedT[T](buffer, offset, count, order)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	end := offset + count*SizeOf[T]()
+	return values, buffer[offset:end:end], nil
+}
+
+// ReadRemainingOrderedT reads as many consecutive values of type T as fit in the given buffer from the specified
+// offset onward, using the specified byte order. If the byte order is nil, it defaults to binary.NativeEndian.
+// Any trailing bytes that do not form a whole element are ignored. It returns io.EOF only for a negative offset;
+// an offset at or past the end of the buffer yields no values.
+// See also: ReadSliceOrderedT.
+func ReadRemainingOrderedT[T Numeric](buffer []byte, offset int, order binary.ByteOrder) ([]T, error) {
+	if offset < 0 {
+		return nil, io.EOF
+	}
+
+	count := max(len(buffer)-offset, 0) / SizeOf[T]()
+	if count == 0 {
+		return []T{}, nil
+	}
+
+	return ReadSliceOrderedT[T](buffer, offset, count, order)
+}
+
+// ReadExactOrderedT reads consecutive values of type T filling the given buffer from the specified offset onward,
+// using the specified byte order. If the byte order is nil, it defaults to binary.NativeEndian.
+// Unlike ReadRemainingOrderedT, the remaining bytes must form a whole number of elements; otherwise
+// ErrTrailingBytes is returned, reporting how many bytes were left over. It returns io.EOF for an offset
+// outside the buffer.
+// See also: ReadRemainingOrderedT.
+func ReadExactOrderedT[T Numeric](buffer []byte, offset int, order binary.ByteOrder) ([]T, error) {
+	if offset < 0 || offset > len(buffer) {
+		return nil, io.EOF
+	}
+
+	size := SizeOf[T]()
+	remaining := len(buffer) - offset
+
+	if trailing := remaining % size; trailing != 0 {
+		return nil, NewErrTrailingBytes(trailing)
+	}
+
+	return ReadSliceOrderedT[T](buffer, offset, remaining/size, order)
+}
+
+// ReadSliceLimitedOrderedT reads count consecutive values of type T from the given buffer starting at the specified
+// offset, using the specified byte order, provided that count does not exceed maxCount. If the byte order is nil,
+// it defaults to binary.NativeEndian. It returns ErrCountExceedsLimit before allocating anything if count is greater
+// than maxCount, which protects parsers of untrusted input from bogus length fields.
+// See also: ReadSliceOrderedT.
+func ReadSliceLimitedOrderedT[T Numeric](buffer []byte, offset, count, maxCount int, order binary.ByteOrder) ([]T, error) {
+	if count > maxCount {
+		return nil, NewErrCountExceedsLimit(count, maxCount)
+	}
+
+	return ReadSliceOrderedT[T](buffer, offset, count, order)
+}
+
+// EqualDecodedT reports whether the first count values of type T decoded from a, using aOrder, equal those decoded
+// from b, using bOrder. If either byte order is nil, it defaults to binary.NativeEndian. This checks, for example,
+// that a little-endian and a big-endian encoding represent the same data. Values are compared with ==, so a NaN
+// is never equal to itself. It returns any error encountered while reading either buffer.
+// See also: ReadSliceOrderedT.
+func EqualDecodedT[T Numeric](a []byte, aOrder binary.ByteOrder, b []byte, bOrder binary.ByteOrder, count int) (bool, error) {
+	aValues, err := ReadSliceOrderedT[T](a, 0, count, aOrder)
+	if err != nil {
+		return false, err
+	}
+
+	bValues, err := ReadSliceOrderedT[T](b, 0, count, bOrder)
+	if err != nil {
+		return false, err
+	}
+
+	return slices.Equal(aValues, bValues), nil
+}
@@ -0,0 +1,384 @@
+package buffergenerics
+
+import (
+	"encoding/binary"
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/stretchr/testify/assert"
+	"io"
+	"math"
+	"testing"
+)
+
+func TestReadArrayOrderedT(t *testing.T) {
+	t.Run("it should fill a 16-element float32 matrix", func(t *testing.T) {
+		order := binary.LittleEndian
+		var want [16]float32
+		buf := make([]byte, len(want)*4)
+		for i := range want {
+			want[i] = gofakeit.Float32()
+			order.PutUint32(buf[i*4:], math.Float32bits(want[i]))
+		}
+
+		var matrix [16]float32
+		err := ReadArrayOrderedT(buf, 0, matrix[:], order)
+
+		assert.NoError(t, err, "it should not return an error")
+		assert.Equal(t, want, matrix)
+	})
+
+	t.Run("it should respect the offset", func(t *testing.T) {
+		order := binary.BigEndian
+		want := [2]uint16{gofakeit.Uint16(), gofakeit.Uint16()}
+		buf := make([]byte, 1+len(want)*2)
+		order.PutUint16(buf[1:], want[0])
+		order.PutUint16(buf[3:], want[1])
+
+		var got [2]uint16
+		err := ReadArrayOrderedT(buf, 1, got[:], order)
+
+		assert.NoError(t, err, "it should not return an error")
+		assert.Equal(t, want, got)
+	})
+
+	t.Run("it should return an EOF error and leave dst untouched when the buffer is too short", func(t *testing.T) {
+		buf := make([]byte, 15*4)
+		var matrix [16]float32
+		matrix[0] = 1
+
+		err := ReadArrayOrderedT(buf, 0, matrix[:], binary.LittleEndian)
+
+		assert.ErrorIs(t, err, io.EOF)
+		assert.Equal(t, float32(1), matrix[0])
+	})
+
+	t.Run("it should return an EOF error for negative offsets", func(t *testing.T) {
+		buf := make([]byte, 8)
+		var dst [1]uint32
+
+		err := ReadArrayOrderedT(buf, -1, dst[:], binary.LittleEndian)
+
+		assert.ErrorIs(t, err, io.EOF)
+	})
+}
+
+func TestReadSliceStrideOrderedT(t *testing.T) {
+	t.Run("it should pick one column out of an array of records", func(t *testing.T) {
+		// struct { uint16 id; uint32 value; uint16 pad; } x 3, picking value
+		order := binary.LittleEndian
+		const stride = 8
+		want := []uint32{gofakeit.Uint32(), gofakeit.Uint32(), gofakeit.Uint32()}
+		buf := make([]byte, len(want)*stride)
+		for i, v := range want {
+			order.PutUint16(buf[i*stride:], gofakeit.Uint16())
+			order.PutUint32(buf[i*stride+2:], v)
+			order.PutUint16(buf[i*stride+6:], 0xFFFF)
+		}
+
+		got, err := ReadSliceStrideOrderedT[uint32](buf, 2, len(want), stride, order)
+
+		assert.NoError(t, err, "it should not return an error")
+		assert.Equal(t, want, got)
+	})
+
+	t.Run("it should not require trailing bytes after the last element", func(t *testing.T) {
+		buf := []byte{0x01, 0xFF, 0xFF, 0x02}
+
+		got, err := ReadSliceStrideOrderedT[uint8](buf, 0, 2, 3, binary.LittleEndian)
+
+		assert.NoError(t, err, "it should not return an error")
+		assert.Equal(t, []uint8{0x01, 0x02}, got)
+	})
+
+	t.Run("it should return an empty slice for a zero count", func(t *testing.T) {
+		got, err := ReadSliceStrideOrderedT[uint32](nil, 0, 0, 4, binary.LittleEndian)
+
+		assert.NoError(t, err, "it should not return an error")
+		assert.Empty(t, got)
+	})
+
+	t.Run("it should return ErrInvalidStride for strides smaller than the element", func(t *testing.T) {
+		buf := make([]byte, 16)
+
+		_, err := ReadSliceStrideOrderedT[uint32](buf, 0, 2, 3, binary.LittleEndian)
+
+		var errStride ErrInvalidStride
+		assert.ErrorAs(t, err, &errStride)
+		assert.Equal(t, 3, errStride.Stride)
+		assert.Equal(t, 4, errStride.Size)
+	})
+
+	t.Run("it should return an EOF error when the last element overruns", func(t *testing.T) {
+		buf := make([]byte, 15)
+
+		_, err := ReadSliceStrideOrderedT[uint32](buf, 0, 2, 12, binary.LittleEndian)
+
+		assert.ErrorIs(t, err, io.EOF)
+	})
+}
+
+func TestReadSliceOrderedT(t *testing.T) {
+	t.Run("it should read consecutive values", func(t *testing.T) {
+		order := binary.BigEndian
+		want := []int16{gofakeit.Int16(), gofakeit.Int16(), gofakeit.Int16()}
+		buf := []byte{0xFF}
+		for _, v := range want {
+			buf, _ = AppendOrderedT(buf, v, order)
+		}
+
+		got, err := ReadSliceOrderedT[int16](buf, 1, len(want), order)
+
+		assert.NoError(t, err, "it should not return an error")
+		assert.Equal(t, want, got)
+	})
+
+	t.Run("it should return an EOF error when the buffer is too short", func(t *testing.T) {
+		buf := make([]byte, 11)
+
+		_, err := ReadSliceOrderedT[uint32](buf, 0, 3, binary.LittleEndian)
+
+		assert.ErrorIs(t, err, io.EOF)
+	})
+}
+
+func TestReadN(t *testing.T) {
+	t.Run("it should read values in the given order", func(t *testing.T) {
+		want := []uint32{gofakeit.Uint32(), gofakeit.Uint32()}
+		buf, _ := AppendSliceOrderedT(nil, want, binary.BigEndian)
+
+		got, err := ReadN[uint32](buf, 0, len(want), binary.BigEndian)
+
+		assert.NoError(t, err, "it should not return an error")
+		assert.Equal(t, want, got)
+	})
+
+	t.Run("it should assume binary.NativeEndian if no order is provided", func(t *testing.T) {
+		want := []int64{gofakeit.Int64(), gofakeit.Int64(), gofakeit.Int64()}
+		buf, _ := AppendSliceOrderedT(nil, want, binary.NativeEndian)
+
+		got, err := ReadN[int64](buf, 0, len(want), nil)
+
+		assert.NoError(t, err, "it should not return an error")
+		assert.Equal(t, want, got)
+		assert.Equal(t, MustReadOrderedT[int64](buf, 8, nil), got[1], "it should match the single-value reader")
+	})
+
+	t.Run("it should return an EOF error when the buffer is too short", func(t *testing.T) {
+		_, err := ReadN[uint16](make([]byte, 3), 0, 2, nil)
+
+		assert.ErrorIs(t, err, io.EOF)
+	})
+}
+
+func TestReadSliceSpanOrderedT(t *testing.T) {
+	t.Run("it should return a span aliasing the consumed region", func(t *testing.T) {
+		buf := []byte{0xAA, 0x00, 0x01, 0x00, 0x02, 0xBB}
+
+		values, span, err := ReadSliceSpanOrderedT[uint16](buf, 1, 2, binary.BigEndian)
+
+		assert.NoError(t, err, "it should not return an error")
+		assert.Equal(t, []uint16{1, 2}, values)
+		assert.Equal(t, []byte{0x00, 0x01, 0x00, 0x02}, span)
+
+		buf[1] = 0xFF
+		assert.Equal(t, byte(0xFF), span[0], "it should not copy the region")
+	})
+
+	t.Run("it should not allow appends to the span to overwrite the buffer", func(t *testing.T) {
+		buf := []byte{0x01, 0x02, 0xBB}
+
+		_, span, _ := ReadSliceSpanOrderedT[uint8](buf, 0, 2, nil)
+		_ = append(span, 0xFF)
+
+		assert.Equal(t, byte(0xBB), buf[2])
+	})
+
+	t.Run("it should return an EOF error and no span when the buffer is too short", func(t *testing.T) {
+		buf := make([]byte, 7)
+
+		values, span, err := ReadSliceSpanOrderedT[uint32](buf, 0, 2, binary.LittleEndian)
+
+		assert.ErrorIs(t, err, io.EOF)
+		assert.Nil(t, values)
+		assert.Nil(t, span)
+	})
+}
+
+func TestReadRemainingOrderedT(t *testing.T) {
+	t.Run("it should leave out a trailing partial element", func(t *testing.T) {
+		order := binary.LittleEndian
+		want := []uint32{gofakeit.Uint32(), gofakeit.Uint32()}
+		buf := []byte{0xAA}
+		for _, v := range want {
+			buf, _ = AppendOrderedT(buf, v, order)
+		}
+		buf = append(buf, 0xBB, 0xCC, 0xDD)
+
+		got, err := ReadRemainingOrderedT[uint32](buf, 1, order)
+
+		assert.NoError(t, err, "it should not return an error")
+		assert.Equal(t, want, got)
+	})
+
+	t.Run("it should return no values at the end of the buffer", func(t *testing.T) {
+		buf := make([]byte, 4)
+
+		atEnd, err := ReadRemainingOrderedT[uint16](buf, len(buf), nil)
+		assert.NoError(t, err, "it should not return an error")
+		assert.Empty(t, atEnd)
+
+		pastEnd, err := ReadRemainingOrderedT[uint16](buf, len(buf)+1, nil)
+		assert.NoError(t, err, "it should not return an error")
+		assert.Empty(t, pastEnd)
+	})
+
+	t.Run("it should return an EOF error for negative offsets", func(t *testing.T) {
+		_, err := ReadRemainingOrderedT[uint16](make([]byte, 4), -1, nil)
+
+		assert.ErrorIs(t, err, io.EOF)
+	})
+}
+
+func TestReadExactOrderedT(t *testing.T) {
+	t.Run("it should read an exact fit", func(t *testing.T) {
+		order := binary.BigEndian
+		want := []uint16{gofakeit.Uint16(), gofakeit.Uint16(), gofakeit.Uint16()}
+		buf := []byte{0xAA}
+		for _, v := range want {
+			buf, _ = AppendOrderedT(buf, v, order)
+		}
+
+		got, err := ReadExactOrderedT[uint16](buf, 1, order)
+
+		assert.NoError(t, err, "it should not return an error")
+		assert.Equal(t, want, got)
+	})
+
+	t.Run("it should return ErrTrailingBytes for a one-byte-extra payload", func(t *testing.T) {
+		buf := make([]byte, 9)
+
+		values, err := ReadExactOrderedT[uint32](buf, 0, binary.LittleEndian)
+
+		var errTrailing ErrTrailingBytes
+		assert.ErrorAs(t, err, &errTrailing)
+		assert.Equal(t, 1, errTrailing.Trailing)
+		assert.Nil(t, values)
+	})
+
+	t.Run("it should return an EOF error for offsets outside the buffer", func(t *testing.T) {
+		buf := make([]byte, 8)
+
+		_, err := ReadExactOrderedT[uint32](buf, -1, binary.LittleEndian)
+		assert.ErrorIs(t, err, io.EOF)
+
+		_, err = ReadExactOrderedT[uint32](buf, 9, binary.LittleEndian)
+		assert.ErrorIs(t, err, io.EOF)
+	})
+}
+
+func TestReadSliceOrderedT_Overflow(t *testing.T) {
+	t.Run("it should return ErrCountOverflow for counts near math.MaxInt", func(t *testing.T) {
+		buf := make([]byte, 16)
+
+		values, err := ReadSliceOrderedT[uint64](buf, 0, math.MaxInt/4, binary.LittleEndian)
+
+		var errOverflow ErrCountOverflow
+		assert.ErrorAs(t, err, &errOverflow)
+		assert.Equal(t, math.MaxInt/4, errOverflow.Count)
+		assert.Equal(t, 8, errOverflow.Size)
+		assert.Nil(t, values)
+	})
+
+	t.Run("it should return ErrCountOverflow for strided counts near math.MaxInt", func(t *testing.T) {
+		buf := make([]byte, 16)
+
+		_, err := ReadSliceStrideOrderedT[uint8](buf, 0, math.MaxInt/2, 4, binary.LittleEndian)
+
+		assert.ErrorAs(t, err, &ErrCountOverflow{})
+	})
+
+	t.Run("it should return an EOF error for large counts that do not overflow", func(t *testing.T) {
+		buf := make([]byte, 16)
+
+		_, err := ReadSliceOrderedT[uint8](buf, 0, math.MaxInt, binary.LittleEndian)
+
+		assert.ErrorIs(t, err, io.EOF)
+	})
+
+	t.Run("it should return ErrCountOverflow from spans", func(t *testing.T) {
+		_, _, err := ReadSliceSpanOrderedT[uint32](make([]byte, 16), 0, math.MaxInt/2, nil)
+
+		assert.ErrorAs(t, err, &ErrCountOverflow{})
+	})
+}
+
+func TestReadSliceLimitedOrderedT(t *testing.T) {
+	t.Run("it should read counts within the limit", func(t *testing.T) {
+		buf := []byte{0x00, 0x01, 0x00, 0x02}
+
+		values, err := ReadSliceLimitedOrderedT[uint16](buf, 0, 2, 2, binary.BigEndian)
+
+		assert.NoError(t, err, "it should not return an error")
+		assert.Equal(t, []uint16{1, 2}, values)
+	})
+
+	t.Run("it should return ErrCountExceedsLimit for counts over the limit", func(t *testing.T) {
+		buf := make([]byte, 1024)
+
+		values, err := ReadSliceLimitedOrderedT[uint16](buf, 0, 300, 256, binary.BigEndian)
+
+		var errLimit ErrCountExceedsLimit
+		assert.ErrorAs(t, err, &errLimit)
+		assert.Equal(t, 300, errLimit.Count)
+		assert.Equal(t, 256, errLimit.Limit)
+		assert.Nil(t, values)
+	})
+
+	t.Run("it should check the limit before the buffer bounds", func(t *testing.T) {
+		_, err := ReadSliceLimitedOrderedT[uint64](nil, 0, math.MaxInt, 1<<20, binary.BigEndian)
+
+		assert.ErrorAs(t, err, &ErrCountExceedsLimit{})
+	})
+}
+
+func TestEqualDecodedT(t *testing.T) {
+	values := []int32{gofakeit.Int32(), gofakeit.Int32(), gofakeit.Int32()}
+	le, _ := AppendSliceOrderedT(nil, values, binary.LittleEndian)
+	be, _ := AppendSliceOrderedT(nil, values, binary.BigEndian)
+
+	t.Run("it should report equivalent encodings as equal", func(t *testing.T) {
+		equal, err := EqualDecodedT[int32](le, binary.LittleEndian, be, binary.BigEndian, len(values))
+
+		assert.NoError(t, err, "it should not return an error")
+		assert.True(t, equal)
+	})
+
+	t.Run("it should report differing encodings as not equal", func(t *testing.T) {
+		other := Clone(be)
+		other[len(other)-1] ^= 0x01
+
+		equal, err := EqualDecodedT[int32](le, binary.LittleEndian, other, binary.BigEndian, len(values))
+
+		assert.NoError(t, err, "it should not return an error")
+		assert.False(t, equal)
+	})
+
+	t.Run("it should report a mismatched order as not equal", func(t *testing.T) {
+		equal, err := EqualDecodedT[int32](le, binary.LittleEndian, be, binary.LittleEndian, len(values))
+
+		assert.NoError(t, err, "it should not return an error")
+		assert.False(t, equal)
+	})
+
+	t.Run("it should compare only the first count values", func(t *testing.T) {
+		equal, err := EqualDecodedT[int32](le, binary.LittleEndian, append(Clone(be), 0xFF), binary.BigEndian, 2)
+
+		assert.NoError(t, err, "it should not return an error")
+		assert.True(t, equal)
+	})
+
+	t.Run("it should return an EOF error if either buffer is too short", func(t *testing.T) {
+		_, err := EqualDecodedT[int32](le, binary.LittleEndian, be[:8], binary.BigEndian, len(values))
+
+		assert.ErrorIs(t, err, io.EOF)
+	})
+}
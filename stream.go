@@ -0,0 +1,203 @@
+package buffergenerics
+
+import (
+	"bufio"
+	"context"
+	"encoding/binary"
+	"io"
+)
+
+// ReadFromReaderOrderedT reads a value of type T from the given reader, using the specified byte order.
+// If the byte order is nil, it defaults to binary.NativeEndian. It consumes exactly SizeOf[T]() bytes and
+// returns the read value and any error encountered during the read operation. The error is io.EOF only if
+// no bytes were read; if the reader ends partway through the value, the error is io.ErrUnexpectedEOF.
+// See also: ReadOrderedT.
+func ReadFromReaderOrderedT[T Numeric](r io.Reader, order binary.ByteOrder) (T, error) {
+	var scratch [8]byte
+	buf := scratch[:SizeOf[T]()]
+
+	if _, err := io.ReadFull(r, buf); err != nil {
+		return *new(T), err
+	}
+
+	return ReadOrderedT[T](buf, 0, order)
+}
+
+// MustReadFromReaderOrderedT reads a value of type T from the given reader, using the specified byte order.
+// If the byte order is nil, it defaults to binary.NativeEndian. It returns the read value.
+// If an error is encountered during the read operation, it panics with the error.
+// See also: ReadFromReaderOrderedT.
+func MustReadFromReaderOrderedT[T Numeric](r io.Reader, order binary.ByteOrder) T {
+	val, err := ReadFromReaderOrderedT[T](r, order)
+	if err != nil {
+		panic(err)
+	}
+
+	return val
+}
+
+// StreamSliceOrderedT reads count consecutive values of type T from the given reader, using the specified byte order.
+// If the byte order is nil, it defaults to binary.NativeEndian. Values are read chunk elements at a time into a
+// reusable scratch buffer, capping its size at chunk*SizeOf[T]() bytes; a chunk less than 1 is treated as 1.
+// The result grows as chunks arrive, so a large count taken from untrusted input does not allocate up front.
+// It returns the read values and any error encountered during the read operation. As with ReadFromReaderOrderedT,
+// the error is io.EOF only if no bytes were read; if the reader ends partway through, it is io.ErrUnexpectedEOF.
+// Like ReadSliceOrderedT, it returns io.EOF for a negative count without reading.
+// See also: ReadSliceOrderedT.
+func StreamSliceOrderedT[T Numeric](r io.Reader, count int, order binary.ByteOrder, chunk int) ([]T, error) {
+	if count < 0 {
+		return nil, io.EOF
+	}
+
+	size := SizeOf[T]()
+	if _, err := extentOf(count, size, size); err != nil {
+		return nil, err
+	}
+
+	chunk = min(max(chunk, 1), count)
+	values := make([]T, 0, chunk)
+	scratch := make([]byte, chunk*size)
+
+	for len(values) < count {
+		n := min(chunk, count-len(values))
+		buf := scratch[:n*size]
+
+		if _, err := io.ReadFull(r, buf); err != nil {
+			if err == io.EOF && len(values) > 0 {
+				err = io.ErrUnexpectedEOF
+			}
+
+			return nil, err
+		}
+
+		for i := range n {
+			val, err := ReadOrderedT[T](buf, i*size, order)
+			if err != nil {
+				return nil, err
+			}
+
+			values = append(values, val)
+		}
+	}
+
+	return values, nil
+}
+
+// writeChunkSize is the size of the scratch buffer used by WriteSliceToWriterOrderedT.
+const writeChunkSize = 4096
+
+// WriteSliceToWriterOrderedT writes each of values consecutively to the given writer, using the specified byte order.
+// If the byte order is nil, it defaults to binary.NativeEndian. Values are encoded into a scratch buffer of at most
+// 4 KiB, which is flushed to w whenever it fills, so no intermediate buffer for all of values is built.
+// It returns the total number of bytes written and any error encountered, including io.ErrShortWrite
+// if w accepts fewer bytes than given without reporting an error.
+// See also: WriteSliceOrderedT.
+func WriteSliceToWriterOrderedT[T Numeric](w io.Writer, values []T, order binary.ByteOrder) (int, error) {
+	size := SizeOf[T]()
+	perChunk := writeChunkSize / size
+	scratch := make([]byte, min(len(values), perChunk)*size)
+
+	total := 0
+	for len(values) > 0 {
+		chunk := values[:min(len(values), perChunk)]
+		values = values[len(chunk):]
+
+		n, err := WriteSliceOrderedT[T](scratch, 0, chunk, order)
+		if err != nil {
+			return total, err
+		}
+
+		written, err := w.Write(scratch[:n])
+		total += written
+		if err == nil && written < n {
+			err = io.ErrShortWrite
+		}
+
+		if err != nil {
+			return total, err
+		}
+	}
+
+	return total, nil
+}
+
+// StreamReader reads consecutive values from an io.ReadSeeker using a default byte order.
+// It is the streaming counterpart of Reader for inputs too large to hold in memory.
+type StreamReader struct {
+	rs    io.ReadSeeker
+	order binary.ByteOrder
+}
+
+// NewStreamReader returns a StreamReader reading from rs using the specified byte order for all reads.
+// If the byte order is nil, it defaults to binary.NativeEndian.
+func NewStreamReader(rs io.ReadSeeker, order binary.ByteOrder) *StreamReader {
+	return &StreamReader{
+		rs:    rs,
+		order: order,
+	}
+}
+
+// StreamSeek sets the position of the next read, passing through to the underlying io.ReadSeeker.
+// It returns the new offset relative to the start of the stream.
+func (s *StreamReader) StreamSeek(off int64, whence int) (int64, error) {
+	return s.rs.Seek(off, whence)
+}
+
+// Seek implements io.Seeker and is equivalent to StreamSeek.
+func (s *StreamReader) Seek(offset int64, whence int) (int64, error) {
+	return s.StreamSeek(offset, whence)
+}
+
+// StreamReadNext reads the next value of type T from the given StreamReader.
+// Errors from the underlying reader, including io.EOF, are propagated.
+// See also: ReadFromReaderOrderedT.
+func StreamReadNext[T Numeric](s *StreamReader) (T, error) {
+	return ReadFromReaderOrderedT[T](s.rs, s.order)
+}
+
+// PeekFromBufioOrderedT decodes the next value of type T from the given bufio.Reader without consuming it,
+// using the specified byte order. If the byte order is nil, it defaults to binary.NativeEndian.
+// This allows branching on a discriminator before reading it. As with ReadFromReaderOrderedT, the error is io.EOF
+// only if no bytes are available; if the stream ends partway through the value, the error is io.ErrUnexpectedEOF.
+// See also: ReadFromReaderOrderedT.
+func PeekFromBufioOrderedT[T Numeric](br *bufio.Reader, order binary.ByteOrder) (T, error) {
+	buf, err := br.Peek(SizeOf[T]())
+	if err != nil {
+		if err == io.EOF && len(buf) > 0 {
+			err = io.ErrUnexpectedEOF
+		}
+
+		return *new(T), err
+	}
+
+	return ReadOrderedT[T](buf, 0, order)
+}
+
+// ReadFromReaderContextOrderedT is like ReadFromReaderOrderedT, but returns ctx.Err() as soon as the given context
+// is done, even if the read is still blocked. The read runs in a separate goroutine which, if abandoned, lingers
+// until the underlying read returns; callers should arrange for r to be closed or unblocked after cancellation.
+// Any bytes consumed by an abandoned read are lost.
+// See also: ReadFromReaderOrderedT.
+func ReadFromReaderContextOrderedT[T Numeric](ctx context.Context, r io.Reader, order binary.ByteOrder) (T, error) {
+	if err := ctx.Err(); err != nil {
+		return *new(T), err
+	}
+
+	type result struct {
+		val T
+		err error
+	}
+
+	done := make(chan result, 1)
+	go func() {
+		val, err := ReadFromReaderOrderedT[T](r, order)
+		done <- result{val, err}
+	}()
+
+	select {
+	case res := <-done:
+		return res.val, res.err
+	case <-ctx.Done():
+		return *new(T), ctx.Err()
+	}
+}
@@ -0,0 +1,358 @@
+package buffergenerics
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/binary"
+	"errors"
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/stretchr/testify/assert"
+	"io"
+	"math"
+	"testing"
+	"time"
+)
+
+func TestReadFromReaderOrderedT(t *testing.T) {
+	t.Run("it should read consecutive values from the reader", func(t *testing.T) {
+		order := binary.BigEndian
+		want16, want64 := gofakeit.Uint16(), gofakeit.Float64()
+		buf, _ := AppendOrderedT(nil, want16, order)
+		buf, _ = AppendOrderedT(buf, want64, order)
+		r := bytes.NewReader(buf)
+
+		got16, err := ReadFromReaderOrderedT[uint16](r, order)
+		assert.NoError(t, err, "it should not return an error")
+		assert.Equal(t, want16, got16)
+
+		got64, err := ReadFromReaderOrderedT[float64](r, order)
+		assert.NoError(t, err, "it should not return an error")
+		assert.Equal(t, want64, got64)
+	})
+
+	t.Run("it should return an EOF error for an empty reader", func(t *testing.T) {
+		_, err := ReadFromReaderOrderedT[uint32](bytes.NewReader(nil), binary.LittleEndian)
+
+		assert.ErrorIs(t, err, io.EOF)
+	})
+
+	t.Run("it should return an unexpected EOF error for a short reader", func(t *testing.T) {
+		_, err := ReadFromReaderOrderedT[uint32](bytes.NewReader([]byte{0xDE, 0xAD}), binary.LittleEndian)
+
+		assert.ErrorIs(t, err, io.ErrUnexpectedEOF)
+	})
+}
+
+func TestMustReadFromReaderOrderedT(t *testing.T) {
+	t.Run("it should panic with unexpected EOF for a short reader", func(t *testing.T) {
+		assert.PanicsWithError(t, "unexpected EOF", func() {
+			_ = MustReadFromReaderOrderedT[uint32](bytes.NewReader([]byte{0xDE, 0xAD}), binary.LittleEndian)
+		})
+	})
+
+	t.Run("it should otherwise passthrough to ReadFromReaderOrderedT", func(t *testing.T) {
+		assert.NotPanics(t, func() {
+			want := gofakeit.Int32()
+			buf := make([]byte, 4)
+			binary.LittleEndian.PutUint32(buf, uint32(want))
+
+			got := MustReadFromReaderOrderedT[int32](bytes.NewReader(buf), binary.LittleEndian)
+
+			assert.Equal(t, want, got)
+		})
+	})
+}
+
+// maxReadReader records the largest read requested from the wrapped reader.
+type maxReadReader struct {
+	io.Reader
+	max int
+}
+
+func (m *maxReadReader) Read(p []byte) (int, error) {
+	m.max = max(m.max, len(p))
+	return m.Reader.Read(p)
+}
+
+func TestStreamSliceOrderedT(t *testing.T) {
+	t.Run("it should match a one-shot ReadSliceOrderedT", func(t *testing.T) {
+		order := binary.LittleEndian
+		values := make([]float32, 37)
+		for i := range values {
+			values[i] = gofakeit.Float32()
+		}
+		buf, _ := AppendSliceOrderedT(nil, values, order)
+		want, _ := ReadSliceOrderedT[float32](buf, 0, len(values), order)
+
+		for _, chunk := range []int{1, 5, 37, 100} {
+			r := &maxReadReader{Reader: bytes.NewReader(buf)}
+
+			got, err := StreamSliceOrderedT[float32](r, len(values), order, chunk)
+
+			assert.NoError(t, err, "it should not return an error")
+			assert.Equal(t, want, got, "chunk %d", chunk)
+			assert.LessOrEqual(t, r.max, min(chunk, len(values))*SizeOf[float32](), "it should cap the scratch buffer")
+		}
+	})
+
+	t.Run("it should return an empty slice for a zero count", func(t *testing.T) {
+		got, err := StreamSliceOrderedT[uint16](bytes.NewReader(nil), 0, nil, 4)
+
+		assert.NoError(t, err, "it should not return an error")
+		assert.Empty(t, got)
+	})
+
+	t.Run("it should return io.EOF for a negative count", func(t *testing.T) {
+		got, err := StreamSliceOrderedT[uint16](bytes.NewReader(make([]byte, 4)), -1, nil, 4)
+
+		assert.ErrorIs(t, err, io.EOF)
+		assert.Nil(t, got)
+	})
+
+	t.Run("it should return io.EOF if the reader is empty", func(t *testing.T) {
+		_, err := StreamSliceOrderedT[uint32](bytes.NewReader(nil), 3, nil, 2)
+
+		assert.ErrorIs(t, err, io.EOF)
+	})
+
+	t.Run("it should return io.ErrUnexpectedEOF if the reader ends partway", func(t *testing.T) {
+		_, err := StreamSliceOrderedT[uint32](bytes.NewReader(make([]byte, 10)), 3, nil, 2)
+
+		assert.ErrorIs(t, err, io.ErrUnexpectedEOF)
+	})
+
+	t.Run("it should not allocate up front for a huge count from a short reader", func(t *testing.T) {
+		got, err := StreamSliceOrderedT[uint64](bytes.NewReader(make([]byte, 20)), math.MaxInt/16, nil, 2)
+
+		assert.ErrorIs(t, err, io.ErrUnexpectedEOF)
+		assert.Nil(t, got)
+	})
+
+	t.Run("it should return ErrCountOverflow for huge counts", func(t *testing.T) {
+		_, err := StreamSliceOrderedT[uint64](bytes.NewReader(nil), math.MaxInt, nil, 2)
+
+		var errOverflow ErrCountOverflow
+		assert.ErrorAs(t, err, &errOverflow)
+	})
+}
+
+// limitedWriter accepts at most n bytes, returning errLimit once they are exhausted.
+type limitedWriter struct {
+	w io.Writer
+	n int
+}
+
+var errLimit = errors.New("limit reached")
+
+func (l *limitedWriter) Write(p []byte) (int, error) {
+	if len(p) > l.n {
+		n, _ := l.w.Write(p[:l.n])
+		l.n = 0
+		return n, errLimit
+	}
+
+	l.n -= len(p)
+	return l.w.Write(p)
+}
+
+// shortWriter accepts at most n bytes per write without reporting an error.
+type shortWriter struct {
+	n int
+}
+
+func (s shortWriter) Write(p []byte) (int, error) {
+	return min(len(p), s.n), nil
+}
+
+func TestWriteSliceToWriterOrderedT(t *testing.T) {
+	t.Run("it should round-trip through ReadSliceOrderedT", func(t *testing.T) {
+		order := binary.BigEndian
+		want := make([]float64, 1500) // spans several chunks
+		for i := range want {
+			want[i] = gofakeit.Float64()
+		}
+		var sink bytes.Buffer
+
+		n, err := WriteSliceToWriterOrderedT(&sink, want, order)
+
+		assert.NoError(t, err, "it should not return an error")
+		assert.Equal(t, len(want)*8, n)
+		got, err := ReadSliceOrderedT[float64](sink.Bytes(), 0, len(want), order)
+		assert.NoError(t, err, "it should not return an error")
+		assert.Equal(t, want, got)
+	})
+
+	t.Run("it should write nothing for an empty slice", func(t *testing.T) {
+		var sink bytes.Buffer
+
+		n, err := WriteSliceToWriterOrderedT[uint16](&sink, nil, nil)
+
+		assert.NoError(t, err, "it should not return an error")
+		assert.Zero(t, n)
+		assert.Zero(t, sink.Len())
+	})
+
+	t.Run("it should return the writer's error and the bytes written", func(t *testing.T) {
+		var sink bytes.Buffer
+		w := &limitedWriter{w: &sink, n: 6000}
+
+		n, err := WriteSliceToWriterOrderedT(w, make([]uint32, 2000), binary.LittleEndian)
+
+		assert.ErrorIs(t, err, errLimit)
+		assert.Equal(t, 6000, n)
+		assert.Equal(t, 6000, sink.Len())
+	})
+
+	t.Run("it should return io.ErrShortWrite for silent short writes", func(t *testing.T) {
+		n, err := WriteSliceToWriterOrderedT(shortWriter{n: 3}, []uint16{1, 2, 3}, nil)
+
+		assert.ErrorIs(t, err, io.ErrShortWrite)
+		assert.Equal(t, 3, n)
+	})
+}
+
+func TestStreamReader(t *testing.T) {
+	order := binary.LittleEndian
+	want := []uint32{gofakeit.Uint32(), gofakeit.Uint32(), gofakeit.Uint32(), gofakeit.Uint32()}
+	var file []byte
+	for _, v := range want {
+		file, _ = AppendOrderedT(file, v, order)
+	}
+
+	t.Run("it should read values sequentially", func(t *testing.T) {
+		s := NewStreamReader(bytes.NewReader(file), order)
+
+		for _, v := range want {
+			got, err := StreamReadNext[uint32](s)
+			assert.NoError(t, err, "it should not return an error")
+			assert.Equal(t, v, got)
+		}
+	})
+
+	t.Run("it should implement io.Seeker", func(t *testing.T) {
+		var s io.Seeker = NewStreamReader(bytes.NewReader(file), order)
+
+		end, err := s.Seek(0, io.SeekEnd)
+
+		assert.NoError(t, err, "it should not return an error")
+		assert.Equal(t, int64(len(file)), end)
+	})
+
+	t.Run("it should seek through the stream", func(t *testing.T) {
+		s := NewStreamReader(bytes.NewReader(file), order)
+
+		pos, err := s.StreamSeek(8, io.SeekStart)
+		assert.NoError(t, err, "it should not return an error")
+		assert.Equal(t, int64(8), pos)
+
+		got, err := StreamReadNext[uint32](s)
+		assert.NoError(t, err, "it should not return an error")
+		assert.Equal(t, want[2], got)
+
+		_, err = s.StreamSeek(-8, io.SeekCurrent)
+		assert.NoError(t, err, "it should not return an error")
+
+		got, err = StreamReadNext[uint32](s)
+		assert.NoError(t, err, "it should not return an error")
+		assert.Equal(t, want[1], got)
+
+		_, err = s.StreamSeek(-4, io.SeekEnd)
+		assert.NoError(t, err, "it should not return an error")
+
+		got, err = StreamReadNext[uint32](s)
+		assert.NoError(t, err, "it should not return an error")
+		assert.Equal(t, want[3], got)
+	})
+
+	t.Run("it should propagate EOF from the underlying reader", func(t *testing.T) {
+		s := NewStreamReader(bytes.NewReader(file), order)
+		_, _ = s.StreamSeek(0, io.SeekEnd)
+
+		_, err := StreamReadNext[uint32](s)
+
+		assert.ErrorIs(t, err, io.EOF)
+	})
+}
+
+func TestPeekFromBufioOrderedT(t *testing.T) {
+	t.Run("it should decode without consuming", func(t *testing.T) {
+		br := bufio.NewReader(bytes.NewReader([]byte{0x01, 0x00, 0x2A}))
+
+		tag, err := PeekFromBufioOrderedT[uint8](br, nil)
+		assert.NoError(t, err, "it should not return an error")
+		assert.Equal(t, uint8(1), tag)
+
+		b, err := br.ReadByte()
+		assert.NoError(t, err, "it should not return an error")
+		assert.Equal(t, byte(0x01), b, "it should still be available after peeking")
+
+		val, err := PeekFromBufioOrderedT[uint16](br, binary.BigEndian)
+		assert.NoError(t, err, "it should not return an error")
+		assert.Equal(t, uint16(0x2A), val)
+
+		read, err := ReadFromReaderOrderedT[uint16](br, binary.BigEndian)
+		assert.NoError(t, err, "it should not return an error")
+		assert.Equal(t, val, read)
+	})
+
+	t.Run("it should return an unexpected EOF error for short peeks", func(t *testing.T) {
+		br := bufio.NewReader(bytes.NewReader([]byte{0x01, 0x02}))
+
+		_, err := PeekFromBufioOrderedT[uint32](br, binary.BigEndian)
+
+		assert.ErrorIs(t, err, io.ErrUnexpectedEOF)
+		assert.Equal(t, 2, br.Buffered(), "it should not consume the short input")
+	})
+
+	t.Run("it should return an EOF error for an exhausted reader", func(t *testing.T) {
+		br := bufio.NewReader(bytes.NewReader(nil))
+
+		_, err := PeekFromBufioOrderedT[uint32](br, binary.BigEndian)
+
+		assert.ErrorIs(t, err, io.EOF)
+	})
+}
+
+func TestReadFromReaderContextOrderedT(t *testing.T) {
+	t.Run("it should read a value when the context is live", func(t *testing.T) {
+		want := gofakeit.Uint64()
+		buf, _ := AppendOrderedT(nil, want, binary.BigEndian)
+
+		got, err := ReadFromReaderContextOrderedT[uint64](context.Background(), bytes.NewReader(buf), binary.BigEndian)
+
+		assert.NoError(t, err, "it should not return an error")
+		assert.Equal(t, want, got)
+	})
+
+	t.Run("it should return promptly when the context is cancelled during a blocking read", func(t *testing.T) {
+		pr, pw := io.Pipe()
+		defer pw.Close()
+
+		ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+		defer cancel()
+
+		start := time.Now()
+		_, err := ReadFromReaderContextOrderedT[uint32](ctx, pr, binary.BigEndian)
+
+		assert.ErrorIs(t, err, context.DeadlineExceeded)
+		assert.Less(t, time.Since(start), time.Second)
+	})
+
+	t.Run("it should not read from an already cancelled context", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		r := bytes.NewReader([]byte{0x01, 0x02, 0x03, 0x04})
+		_, err := ReadFromReaderContextOrderedT[uint32](ctx, r, binary.BigEndian)
+
+		assert.ErrorIs(t, err, context.Canceled)
+		assert.Equal(t, 4, r.Len(), "it should not consume input")
+	})
+
+	t.Run("it should propagate read errors", func(t *testing.T) {
+		_, err := ReadFromReaderContextOrderedT[uint32](context.Background(), bytes.NewReader([]byte{0x01}), nil)
+
+		assert.ErrorIs(t, err, io.ErrUnexpectedEOF)
+	})
+}
@@ -0,0 +1,88 @@
+package buffergenerics
+
+import (
+	"encoding/binary"
+	"io"
+	"unsafe"
+)
+
+// readLengthPrefix reads an unsigned length prefix of lengthSize bytes from the given buffer at the specified
+// offset, using the specified byte order, and checks that the region it describes fits in the buffer.
+// It returns the start and end of the region following the prefix, ErrInvalidLengthSize if lengthSize is not
+// 1, 2, 4, or 8, and io.EOF if the prefix or the region lies outside the buffer.
+func readLengthPrefix(buffer []byte, offset, lengthSize int, order binary.ByteOrder) (start, end int, err error) {
+	var length uint64
+
+	switch lengthSize {
+	case 1:
+		var l uint8
+		l, err = ReadOrderedT[uint8](buffer, offset, order)
+		length = uint64(l)
+	case 2:
+		var l uint16
+		l, err = ReadOrderedT[uint16](buffer, offset, order)
+		length = uint64(l)
+	case 4:
+		var l uint32
+		l, err = ReadOrderedT[uint32](buffer, offset, order)
+		length = uint64(l)
+	case 8:
+		length, err = ReadOrderedT[uint64](buffer, offset, order)
+	default:
+		return 0, 0, NewErrInvalidLengthSize(lengthSize)
+	}
+
+	if err != nil {
+		return 0, 0, err
+	}
+
+	start = offset + lengthSize
+	if length > uint64(len(buffer)-start) {
+		return 0, 0, io.EOF
+	}
+
+	return start, start + int(length), nil
+}
+
+// ReadStringOrdered reads a length-prefixed string from the given buffer starting at the specified offset, using
+// the specified byte order for the prefix of lengthSize bytes (1, 2, 4, or 8). If the byte order is nil, it defaults
+// to binary.NativeEndian. It returns a copy of the string and the total number of bytes consumed, including the
+// prefix. It returns ErrInvalidLengthSize for an unsupported lengthSize and io.EOF if the string is truncated.
+func ReadStringOrdered(buffer []byte, offset, lengthSize int, order binary.ByteOrder) (string, int, error) {
+	start, end, err := readLengthPrefix(buffer, offset, lengthSize, order)
+	if err != nil {
+		return "", 0, err
+	}
+
+	return string(buffer[start:end]), end - offset, nil
+}
+
+// MustReadStringOrdered reads a length-prefixed string from the given buffer starting at the specified offset,
+// using the specified byte order for the prefix of lengthSize bytes. It returns a copy of the string and the total
+// number of bytes consumed. If an error is encountered during the read operation, it panics with the error.
+// See also: ReadStringOrdered.
+func MustReadStringOrdered(buffer []byte, offset, lengthSize int, order binary.ByteOrder) (string, int) {
+	val, n, err := ReadStringOrdered(buffer, offset, lengthSize, order)
+	if err != nil {
+		panic(err)
+	}
+
+	return val, n
+}
+
+// ReadStringNoCopyOrdered is like ReadStringOrdered but returns a string aliasing the buffer bytes instead of
+// a copy, avoiding an allocation. The string is only valid while the buffer is alive and unmodified: since Go
+// strings are assumed immutable, modifying the underlying bytes afterward results in undefined behavior.
+// See also: ReadStringOrdered.
+func ReadStringNoCopyOrdered(buffer []byte, offset, lengthSize int, order binary.ByteOrder) (string, int, error) {
+	start, end, err := readLengthPrefix(buffer, offset, lengthSize, order)
+	if err != nil {
+		return "", 0, err
+	}
+
+	if start == end {
+		return "", end - offset, nil
+	}
+
+	return unsafe.String(&buffer[start], end-start), end - offset, nil
+}
@@ -0,0 +1,150 @@
+package buffergenerics
+
+import (
+	"encoding/binary"
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/stretchr/testify/assert"
+	"io"
+	"testing"
+)
+
+func appendString(t testing.TB, buf []byte, s string, lengthSize int, order binary.ByteOrder) []byte {
+	var err error
+
+	switch lengthSize {
+	case 1:
+		buf, err = AppendOrderedT(buf, uint8(len(s)), order)
+	case 2:
+		buf, err = AppendOrderedT(buf, uint16(len(s)), order)
+	case 4:
+		buf, err = AppendOrderedT(buf, uint32(len(s)), order)
+	case 8:
+		buf, err = AppendOrderedT(buf, uint64(len(s)), order)
+	}
+
+	assert.NoError(t, err)
+	return append(buf, s...)
+}
+
+func TestReadStringOrdered(t *testing.T) {
+	t.Run("it should read strings with each prefix size", func(t *testing.T) {
+		for _, lengthSize := range []int{1, 2, 4, 8} {
+			order := binary.BigEndian
+			want := gofakeit.LetterN(20)
+			buf := appendString(t, []byte{0xAA}, want, lengthSize, order)
+
+			got, n, err := ReadStringOrdered(buf, 1, lengthSize, order)
+
+			assert.NoError(t, err, "it should not return an error")
+			assert.Equal(t, want, got)
+			assert.Equal(t, lengthSize+len(want), n)
+		}
+	})
+
+	t.Run("it should return ErrInvalidLengthSize for unsupported prefix sizes", func(t *testing.T) {
+		_, _, err := ReadStringOrdered(make([]byte, 8), 0, 3, binary.BigEndian)
+
+		var errSize ErrInvalidLengthSize
+		assert.ErrorAs(t, err, &errSize)
+		assert.Equal(t, 3, errSize.Size)
+	})
+
+	t.Run("it should return an EOF error for truncated strings", func(t *testing.T) {
+		buf := appendString(t, nil, "hello", 2, binary.BigEndian)
+
+		_, _, err := ReadStringOrdered(buf[:len(buf)-1], 0, 2, binary.BigEndian)
+
+		assert.ErrorIs(t, err, io.EOF)
+	})
+
+	t.Run("it should return an EOF error for huge length prefixes", func(t *testing.T) {
+		buf, _ := AppendOrderedT[uint64](nil, 1<<63, binary.BigEndian)
+
+		_, _, err := ReadStringOrdered(buf, 0, 8, binary.BigEndian)
+
+		assert.ErrorIs(t, err, io.EOF)
+	})
+}
+
+func TestMustReadStringOrdered(t *testing.T) {
+	t.Run("it should return the string and bytes consumed", func(t *testing.T) {
+		want := gofakeit.LetterN(12)
+		buf := appendString(t, nil, want, 2, binary.LittleEndian)
+
+		got, n := MustReadStringOrdered(buf, 0, 2, binary.LittleEndian)
+
+		assert.Equal(t, want, got)
+		assert.Equal(t, 2+len(want), n)
+	})
+
+	t.Run("it should panic on short input", func(t *testing.T) {
+		buf := appendString(t, nil, "hello", 1, binary.LittleEndian)
+
+		assert.PanicsWithError(t, "EOF", func() {
+			MustReadStringOrdered(buf[:len(buf)-1], 0, 1, binary.LittleEndian)
+		})
+	})
+}
+
+func TestReadStringNoCopyOrdered(t *testing.T) {
+	t.Run("it should match the copying version", func(t *testing.T) {
+		for _, lengthSize := range []int{1, 2, 4, 8} {
+			order := binary.LittleEndian
+			buf := appendString(t, nil, gofakeit.Sentence(5), lengthSize, order)
+
+			want, wantN, wantErr := ReadStringOrdered(buf, 0, lengthSize, order)
+			got, gotN, gotErr := ReadStringNoCopyOrdered(buf, 0, lengthSize, order)
+
+			assert.Equal(t, want, got)
+			assert.Equal(t, wantN, gotN)
+			assert.Equal(t, wantErr, gotErr)
+		}
+	})
+
+	t.Run("it should read empty strings", func(t *testing.T) {
+		buf := appendString(t, nil, "", 1, binary.LittleEndian)
+
+		got, n, err := ReadStringNoCopyOrdered(buf, 0, 1, binary.LittleEndian)
+
+		assert.NoError(t, err, "it should not return an error")
+		assert.Empty(t, got)
+		assert.Equal(t, 1, n)
+	})
+
+	t.Run("it should alias the buffer", func(t *testing.T) {
+		buf := appendString(t, nil, "hello", 1, binary.LittleEndian)
+
+		got, _, _ := ReadStringNoCopyOrdered(buf, 0, 1, binary.LittleEndian)
+		buf[1] = 'j'
+
+		assert.Equal(t, "jello", got)
+	})
+
+	t.Run("it should not allocate", func(t *testing.T) {
+		buf := appendString(t, nil, gofakeit.Sentence(5), 4, binary.LittleEndian)
+
+		allocs := testing.AllocsPerRun(100, func() {
+			_, _, _ = ReadStringNoCopyOrdered(buf, 0, 4, binary.LittleEndian)
+		})
+
+		assert.Zero(t, allocs)
+	})
+}
+
+func BenchmarkReadStringOrdered(b *testing.B) {
+	buf := appendString(b, nil, gofakeit.Sentence(10), 4, binary.LittleEndian)
+	b.ReportAllocs()
+
+	for i := 0; i < b.N; i++ {
+		_, _, _ = ReadStringOrdered(buf, 0, 4, binary.LittleEndian)
+	}
+}
+
+func BenchmarkReadStringNoCopyOrdered(b *testing.B) {
+	buf := appendString(b, nil, gofakeit.Sentence(10), 4, binary.LittleEndian)
+	b.ReportAllocs()
+
+	for i := 0; i < b.N; i++ {
+		_, _, _ = ReadStringNoCopyOrdered(buf, 0, 4, binary.LittleEndian)
+	}
+}
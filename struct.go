@@ -0,0 +1,109 @@
+package buffergenerics
+
+import (
+	"encoding/binary"
+	"reflect"
+)
+
+// DecodeOptions controls the field layout assumed by DecodeStruct.
+// The zero value lays fields out with their natural C alignment.
+type DecodeOptions struct {
+	// MaxAlign, if positive, caps the alignment of every field, as with #pragma pack(n).
+	MaxAlign int
+
+	// Packed removes all padding between fields, as with #pragma pack(1). It takes precedence over MaxAlign.
+	Packed bool
+}
+
+// alignOf returns the alignment of typ under the given options: the size of a scalar, the alignment of an array's
+// element, or the largest alignment of a struct's fields, capped as requested by opts.
+func (opts DecodeOptions) alignOf(typ reflect.Type) int {
+	if opts.Packed {
+		return 1
+	}
+
+	align := 1
+	switch typ.Kind() {
+	case reflect.Array:
+		align = opts.alignOf(typ.Elem())
+	case reflect.Struct:
+		for i := range typ.NumField() {
+			align = max(align, opts.alignOf(typ.Field(i).Type))
+		}
+	default:
+		align = max(int(typ.Size()), 1)
+	}
+
+	if opts.MaxAlign > 0 {
+		align = min(align, opts.MaxAlign)
+	}
+
+	return align
+}
+
+// DecodeStruct decodes the fields of the struct pointed to by dst from the given buffer starting at the specified
+// offset, using the specified byte order. If the byte order is nil, it defaults to binary.NativeEndian.
+// Fields are laid out in declaration order following C rules, relative to offset: each field is padded to its
+// alignment, as adjusted by opts, and the struct is padded to a multiple of its own alignment. Fields may be
+// fixed-width integers, floats, and bools, or arrays and structs thereof; blank and unexported fields are skipped
+// over but not stored.
+// It returns the number of bytes consumed, including padding, ErrScanArg if dst is not a non-nil pointer to a
+// struct, and ErrField naming the first field that fails to decode, wrapping ErrUnknownKind for an unsupported
+// field type or the underlying read error.
+// See also: Scan, AlignOffset.
+func DecodeStruct(buffer []byte, offset int, dst any, order binary.ByteOrder, opts DecodeOptions) (int, error) {
+	ptr := reflect.ValueOf(dst)
+	if ptr.Kind() != reflect.Pointer || ptr.IsNil() || ptr.Elem().Kind() != reflect.Struct {
+		return 0, NewErrScanArg(0, reflect.TypeOf(dst))
+	}
+
+	end, err := decodeValue(buffer, offset, order, ptr.Elem(), opts)
+	if err != nil {
+		return 0, err
+	}
+
+	return end - offset, nil
+}
+
+// decodeValue decodes v from the given buffer at the specified offset and returns the offset immediately following
+// it, including any trailing padding. The fields of a struct are aligned relative to the start of that struct, not
+// to the start of the buffer, so a struct may begin at any offset.
+func decodeValue(buffer []byte, offset int, order binary.ByteOrder, v reflect.Value, opts DecodeOptions) (int, error) {
+	switch v.Kind() {
+	case reflect.Struct:
+		typ := v.Type()
+		start := offset
+		for i := range typ.NumField() {
+			field := typ.Field(i)
+			offset = start + AlignOffset(offset-start, opts.alignOf(field.Type))
+
+			fv := v.Field(i)
+			if !fv.CanSet() {
+				fv = reflect.New(field.Type).Elem()
+			}
+
+			var err error
+			if offset, err = decodeValue(buffer, offset, order, fv, opts); err != nil {
+				return 0, NewErrField(field.Name, err)
+			}
+		}
+
+		return start + AlignOffset(offset-start, opts.alignOf(typ)), nil
+	case reflect.Array:
+		for i := range v.Len() {
+			var err error
+			if offset, err = decodeValue(buffer, offset, order, v.Index(i), opts); err != nil {
+				return 0, err
+			}
+		}
+
+		return offset, nil
+	}
+
+	n, err := scanValue(buffer, offset, order, v)
+	if err != nil {
+		return 0, err
+	}
+
+	return offset + n, nil
+}
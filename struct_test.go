@@ -0,0 +1,154 @@
+package buffergenerics
+
+import (
+	"encoding/binary"
+	"github.com/stretchr/testify/assert"
+	"io"
+	"testing"
+)
+
+// testCStruct mirrors struct { uint8_t a; uint32_t b; uint16_t c; }.
+type testCStruct struct {
+	A uint8
+	B uint32
+	C uint16
+}
+
+func TestDecodeStruct(t *testing.T) {
+	want := testCStruct{A: 0x11, B: 0x22334455, C: 0x6677}
+
+	t.Run("it should lay fields out with natural alignment by default", func(t *testing.T) {
+		// a at 0, b at 4, c at 8, padded to 12.
+		buf := []byte{
+			0x11, 0xEE, 0xEE, 0xEE,
+			0x22, 0x33, 0x44, 0x55,
+			0x66, 0x77, 0xEE, 0xEE,
+		}
+
+		var got testCStruct
+		n, err := DecodeStruct(buf, 0, &got, binary.BigEndian, DecodeOptions{})
+
+		assert.NoError(t, err, "it should not return an error")
+		assert.Equal(t, want, got)
+		assert.Equal(t, 12, n)
+	})
+
+	t.Run("it should remove padding when packed", func(t *testing.T) {
+		// a at 0, b at 1, c at 5, size 7.
+		buf := []byte{0x11, 0x22, 0x33, 0x44, 0x55, 0x66, 0x77}
+
+		var got testCStruct
+		n, err := DecodeStruct(buf, 0, &got, binary.BigEndian, DecodeOptions{Packed: true, MaxAlign: 8})
+
+		assert.NoError(t, err, "it should not return an error")
+		assert.Equal(t, want, got)
+		assert.Equal(t, 7, n)
+	})
+
+	t.Run("it should cap alignment at MaxAlign", func(t *testing.T) {
+		// a at 0, b at 2, c at 6, padded to 8.
+		buf := []byte{0x11, 0xEE, 0x22, 0x33, 0x44, 0x55, 0x66, 0x77}
+
+		var got testCStruct
+		n, err := DecodeStruct(buf, 0, &got, binary.BigEndian, DecodeOptions{MaxAlign: 2})
+
+		assert.NoError(t, err, "it should not return an error")
+		assert.Equal(t, want, got)
+		assert.Equal(t, 8, n)
+	})
+
+	t.Run("it should decode nested structs, arrays, and skip blank fields", func(t *testing.T) {
+		type record struct {
+			Tag    [3]byte
+			_      uint8
+			Inner  testCStruct
+			Scales [2]float32
+			Valid  bool
+		}
+		// Tag at 0, blank at 3, Inner at 4 (size 12), Scales at 16, Valid at 24, padded to 28.
+		buf := make([]byte, 28)
+		copy(buf[0:], "abc")
+		buf[3] = 0xEE
+		copy(buf[4:], []byte{0x11, 0, 0, 0, 0x55, 0x44, 0x33, 0x22, 0x77, 0x66})
+		binary.LittleEndian.PutUint32(buf[16:], 0x3F800000)
+		binary.LittleEndian.PutUint32(buf[20:], 0x40000000)
+		buf[24] = 1
+
+		var got record
+		n, err := DecodeStruct(buf, 0, &got, binary.LittleEndian, DecodeOptions{})
+
+		assert.NoError(t, err, "it should not return an error")
+		assert.Equal(t, [3]byte{'a', 'b', 'c'}, got.Tag)
+		assert.Equal(t, want, got.Inner)
+		assert.Equal(t, [2]float32{1, 2}, got.Scales)
+		assert.True(t, got.Valid)
+		assert.Equal(t, 28, n)
+	})
+
+	t.Run("it should align fields relative to a misaligned starting offset", func(t *testing.T) {
+		// a at 1, b at 5, c at 9, padded to 13.
+		buf := []byte{
+			0xEE,
+			0x11, 0xEE, 0xEE, 0xEE,
+			0x22, 0x33, 0x44, 0x55,
+			0x66, 0x77, 0xEE, 0xEE,
+		}
+
+		var got testCStruct
+		n, err := DecodeStruct(buf, 1, &got, binary.BigEndian, DecodeOptions{})
+
+		assert.NoError(t, err, "it should not return an error")
+		assert.Equal(t, want, got)
+		assert.Equal(t, 12, n)
+	})
+
+	t.Run("it should align nested struct fields relative to the nested struct", func(t *testing.T) {
+		type record struct {
+			Tag   uint8
+			Inner testCStruct
+		}
+		// Starting at 3: Tag at 3, Inner at 7 with a at 7, b at 11, c at 15, padded to 19.
+		buf := make([]byte, 3+16)
+		buf[3] = 0x01
+		copy(buf[7:], []byte{0x11, 0xEE, 0xEE, 0xEE, 0x22, 0x33, 0x44, 0x55, 0x66, 0x77})
+
+		var got record
+		n, err := DecodeStruct(buf, 3, &got, binary.BigEndian, DecodeOptions{})
+
+		assert.NoError(t, err, "it should not return an error")
+		assert.Equal(t, uint8(0x01), got.Tag)
+		assert.Equal(t, want, got.Inner)
+		assert.Equal(t, 16, n)
+	})
+
+	t.Run("it should return ErrField naming a truncated field", func(t *testing.T) {
+		var got testCStruct
+		_, err := DecodeStruct(make([]byte, 9), 0, &got, nil, DecodeOptions{})
+
+		var errField ErrField
+		assert.ErrorAs(t, err, &errField)
+		assert.Equal(t, "C", errField.Field)
+		assert.ErrorIs(t, err, io.EOF)
+	})
+
+	t.Run("it should return ErrField wrapping ErrUnknownKind for unsupported fields", func(t *testing.T) {
+		var got struct {
+			Name string
+		}
+		_, err := DecodeStruct(make([]byte, 16), 0, &got, nil, DecodeOptions{})
+
+		var errKind ErrUnknownKind
+		assert.ErrorAs(t, err, &errKind)
+	})
+
+	t.Run("it should return ErrScanArg for a non-struct destination", func(t *testing.T) {
+		var i uint32
+
+		for _, dst := range []any{nil, testCStruct{}, &i, (*testCStruct)(nil)} {
+			_, err := DecodeStruct(make([]byte, 16), 0, dst, nil, DecodeOptions{})
+
+			var errArg ErrScanArg
+			assert.ErrorAs(t, err, &errArg)
+		}
+	})
+}
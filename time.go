@@ -0,0 +1,32 @@
+package buffergenerics
+
+import (
+	"encoding/binary"
+	"time"
+)
+
+// ReadUnixOrderedT reads an int64 count of seconds since the Unix epoch from the given buffer starting at the
+// specified offset, using the specified byte order. If the byte order is nil, it defaults to binary.NativeEndian.
+// It returns the corresponding time.Time in UTC and any error encountered during the read operation.
+// See also: ReadOrderedT.
+func ReadUnixOrderedT(buffer []byte, offset int, order binary.ByteOrder) (time.Time, error) {
+	sec, err := ReadOrderedT[int64](buffer, offset, order)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	return time.Unix(sec, 0).UTC(), nil
+}
+
+// ReadUnixNanoOrderedT reads an int64 count of nanoseconds since the Unix epoch from the given buffer starting at
+// the specified offset, using the specified byte order. If the byte order is nil, it defaults to binary.NativeEndian.
+// It returns the corresponding time.Time in UTC and any error encountered during the read operation.
+// See also: ReadOrderedT.
+func ReadUnixNanoOrderedT(buffer []byte, offset int, order binary.ByteOrder) (time.Time, error) {
+	nsec, err := ReadOrderedT[int64](buffer, offset, order)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	return time.Unix(0, nsec).UTC(), nil
+}
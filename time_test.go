@@ -0,0 +1,61 @@
+package buffergenerics
+
+import (
+	"encoding/binary"
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/stretchr/testify/assert"
+	"io"
+	"testing"
+	"time"
+)
+
+func doTestReadUnix_Order(t *testing.T, order binary.ByteOrder) {
+	name := order.String()
+
+	t.Run("it should round-trip "+name+" Unix seconds as UTC", func(t *testing.T) {
+		want := gofakeit.Date().Truncate(time.Second)
+		buf := make([]byte, 8)
+		order.PutUint64(buf, uint64(want.Unix()))
+
+		got, err := ReadUnixOrderedT(buf, 0, order)
+
+		assert.NoError(t, err, "it should not return an error")
+		assert.True(t, want.Equal(got), "it should represent the same instant")
+		assert.Equal(t, time.UTC, got.Location())
+	})
+
+	t.Run("it should round-trip "+name+" Unix nanoseconds as UTC", func(t *testing.T) {
+		want := time.Date(2024, time.July, 8, 12, 34, 56, 789012345, time.FixedZone("UTC-5", -5*60*60))
+		buf := make([]byte, 8)
+		order.PutUint64(buf, uint64(want.UnixNano()))
+
+		got, err := ReadUnixNanoOrderedT(buf, 0, order)
+
+		assert.NoError(t, err, "it should not return an error")
+		assert.True(t, want.Equal(got), "it should represent the same instant")
+		assert.Equal(t, time.UTC, got.Location())
+		assert.Equal(t, 17, got.Hour())
+	})
+}
+
+func TestReadUnixOrderedT_BigEndian(t *testing.T) {
+	doTestReadUnix_Order(t, binary.BigEndian)
+}
+
+func TestReadUnixOrderedT_LittleEndian(t *testing.T) {
+	doTestReadUnix_Order(t, binary.LittleEndian)
+}
+
+func TestReadUnixOrderedT_EOF(t *testing.T) {
+	t.Run("it should return an EOF error and the zero time for short buffers", func(t *testing.T) {
+		buf := make([]byte, 4)
+
+		sec, err := ReadUnixOrderedT(buf, 0, binary.LittleEndian)
+		assert.ErrorIs(t, err, io.EOF)
+		assert.True(t, sec.IsZero())
+
+		nsec, err := ReadUnixNanoOrderedT(buf, 0, binary.LittleEndian)
+		assert.ErrorIs(t, err, io.EOF)
+		assert.True(t, nsec.IsZero())
+	})
+}
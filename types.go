@@ -0,0 +1,11 @@
+package buffergenerics
+
+import (
+	"golang.org/x/exp/constraints"
+)
+
+// Numeric is the set of types that can be read from and written to buffers by this package.
+// It is shared by every generic function so that the supported types are defined in one place.
+type Numeric interface {
+	constraints.Integer | constraints.Float
+}
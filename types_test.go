@@ -0,0 +1,34 @@
+package buffergenerics
+
+import (
+	"github.com/stretchr/testify/assert"
+	"reflect"
+	"testing"
+)
+
+func kindOf[T Numeric]() reflect.Kind {
+	return reflect.TypeFor[T]().Kind()
+}
+
+func TestNumeric(t *testing.T) {
+	t.Run("it should cover the expected kinds", func(t *testing.T) {
+		// Each instantiation below is also a compile-time assertion that the type satisfies Numeric.
+		kinds := []reflect.Kind{
+			kindOf[int](), kindOf[int8](), kindOf[int16](), kindOf[int32](), kindOf[int64](),
+			kindOf[uint](), kindOf[uint8](), kindOf[uint16](), kindOf[uint32](), kindOf[uint64](), kindOf[uintptr](),
+			kindOf[float32](), kindOf[float64](),
+		}
+
+		assert.Equal(t, []reflect.Kind{
+			reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+			reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr,
+			reflect.Float32, reflect.Float64,
+		}, kinds)
+	})
+
+	t.Run("it should cover named types by their underlying kind", func(t *testing.T) {
+		type myType int32
+
+		assert.Equal(t, reflect.Int32, kindOf[myType]())
+	})
+}
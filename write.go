@@ -0,0 +1,107 @@
+package buffergenerics
+
+import (
+	"encoding/binary"
+	"io"
+	"math"
+	"reflect"
+	"slices"
+)
+
+// WriteOrderedT writes a value of type T to the given buffer starting at the specified offset,
+// using the specified byte order. If the byte order is nil, it defaults to binary.NativeEndian.
+// It returns any error encountered during the write operation; the buffer is not modified on error.
+// See also: ReadOrderedT.
+func WriteOrderedT[T Numeric](buffer []byte, offset int, value T, order binary.ByteOrder) error {
+	if order == nil {
+		order = binary.ByteOrder(binary.NativeEndian)
+	}
+
+	kind, size := kindAndSizeOf[T]()
+	end := offset + size
+
+	if offset < 0 || offset > len(buffer)-size {
+		return io.EOF
+	}
+
+	switch kind {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		// See ReadOrderedT for why integers are dispatched by width.
+		switch size {
+		case 1:
+			buffer[offset] = byte(value)
+			return nil
+		case 2:
+			order.PutUint16(buffer[offset:end], uint16(value))
+			return nil
+		case 4:
+			order.PutUint32(buffer[offset:end], uint32(value))
+			return nil
+		case 8:
+			order.PutUint64(buffer[offset:end], uint64(value))
+			return nil
+		}
+	case reflect.Float32:
+		order.PutUint32(buffer[offset:end], math.Float32bits(float32(value)))
+		return nil
+	case reflect.Float64:
+		order.PutUint64(buffer[offset:end], math.Float64bits(float64(value)))
+		return nil
+	}
+
+	return NewErrUnknownKind(kind)
+}
+
+// AppendOrderedT appends the encoding of a value of type T to the given buffer, using the specified byte order.
+// If the byte order is nil, it defaults to binary.NativeEndian. It returns the extended buffer and any error
+// encountered during the write operation; on error, the original buffer is returned.
+// See also: WriteOrderedT.
+func AppendOrderedT[T Numeric](buffer []byte, value T, order binary.ByteOrder) ([]byte, error) {
+	offset := len(buffer)
+	grown := append(buffer, make([]byte, SizeOf[T]())...)
+
+	if err := WriteOrderedT[T](grown, offset, value, order); err != nil {
+		return buffer, err
+	}
+
+	return grown, nil
+}
+
+// WriteSliceOrderedT writes each of values consecutively to the given buffer starting at the specified offset,
+// using the specified byte order. If the byte order is nil, it defaults to binary.NativeEndian.
+// It returns the number of bytes written and any error encountered during the write operation, including io.EOF
+// if the buffer cannot hold all of values; the buffer is not modified in that case.
+// See also: WriteOrderedT, ReadSliceOrderedT.
+func WriteSliceOrderedT[T Numeric](buffer []byte, offset int, values []T, order binary.ByteOrder) (int, error) {
+	size := SizeOf[T]()
+
+	if offset < 0 || len(values) > (len(buffer)-offset)/size {
+		return 0, io.EOF
+	}
+
+	for i, v := range values {
+		if err := WriteOrderedT[T](buffer, offset+i*size, v, order); err != nil {
+			return i * size, err
+		}
+	}
+
+	return len(values) * size, nil
+}
+
+// AppendSliceOrderedT appends the encoding of each of values to the given buffer, using the specified byte order.
+// If the byte order is nil, it defaults to binary.NativeEndian. The buffer is grown at most once, by exactly
+// len(values)*SizeOf[T]() bytes, to avoid repeated reallocation. It returns the extended buffer and any error
+// encountered during the write operation; on error, the original buffer is returned.
+// See also: WriteSliceOrderedT.
+func AppendSliceOrderedT[T Numeric](buffer []byte, values []T, order binary.ByteOrder) ([]byte, error) {
+	offset := len(buffer)
+	n := len(values) * SizeOf[T]()
+	grown := slices.Grow(buffer, n)[:offset+n]
+
+	if _, err := WriteSliceOrderedT[T](grown, offset, values, order); err != nil {
+		return buffer, err
+	}
+
+	return grown, nil
+}
@@ -0,0 +1,235 @@
+package buffergenerics
+
+import (
+	"encoding/binary"
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/stretchr/testify/assert"
+	"io"
+	"math"
+	"testing"
+)
+
+func TestWriteOrderedT(t *testing.T) {
+	t.Run("it should return an EOF error for out-of-bounds writes", func(t *testing.T) {
+		buf := []byte{0xDE, 0xAD, 0xCA, 0xFE}
+
+		err := WriteOrderedT[byte](buf, len(buf)+gofakeit.IntRange(0, 100), 0xFF, binary.LittleEndian)
+
+		assert.ErrorIs(t, err, io.EOF)
+		assert.Equal(t, []byte{0xDE, 0xAD, 0xCA, 0xFE}, buf)
+	})
+
+	t.Run("it should return an EOF error for too-large-type writes", func(t *testing.T) {
+		buf := []byte{0xDE, 0xAD, 0xCA, 0xFE}
+
+		err := WriteOrderedT[int64](buf, 0, gofakeit.Int64(), binary.LittleEndian)
+
+		assert.ErrorIs(t, err, io.EOF)
+		assert.Equal(t, []byte{0xDE, 0xAD, 0xCA, 0xFE}, buf)
+	})
+
+	t.Run("it should assume binary.NativeEndian if no order is provided", func(t *testing.T) {
+		want := gofakeit.Int64()
+		buf := make([]byte, 8)
+
+		err := WriteOrderedT(buf, 0, want, nil)
+
+		assert.NoError(t, err, "it should not return an error")
+		assert.Equal(t, uint64(want), binary.NativeEndian.Uint64(buf))
+	})
+
+	t.Run("it should write each width in the given order", func(t *testing.T) {
+		order := binary.BigEndian
+		buf := make([]byte, 2+4+8+4+8+1)
+
+		assert.NoError(t, WriteOrderedT[int16](buf, 0, -2, order))
+		assert.NoError(t, WriteOrderedT[uint32](buf, 2, 0xDEADCAFE, order))
+		assert.NoError(t, WriteOrderedT[uint64](buf, 6, 0x0102030405060708, order))
+		assert.NoError(t, WriteOrderedT[float32](buf, 14, 1.5, order))
+		assert.NoError(t, WriteOrderedT[float64](buf, 18, -2.25, order))
+		assert.NoError(t, WriteOrderedT[int8](buf, 26, -1, order))
+
+		assert.Equal(t, uint16(0xFFFE), order.Uint16(buf[0:]))
+		assert.Equal(t, uint32(0xDEADCAFE), order.Uint32(buf[2:]))
+		assert.Equal(t, uint64(0x0102030405060708), order.Uint64(buf[6:]))
+		assert.Equal(t, float32(1.5), math.Float32frombits(order.Uint32(buf[14:])))
+		assert.Equal(t, -2.25, math.Float64frombits(order.Uint64(buf[18:])))
+		assert.Equal(t, byte(0xFF), buf[26])
+	})
+}
+
+func TestAppendOrderedT(t *testing.T) {
+	t.Run("it should append the encoding of the value", func(t *testing.T) {
+		buf := []byte{0xDE, 0xAD}
+
+		buf, err := AppendOrderedT[uint32](buf, 0xCAFEBABE, binary.LittleEndian)
+
+		assert.NoError(t, err, "it should not return an error")
+		assert.Equal(t, []byte{0xDE, 0xAD, 0xBE, 0xBA, 0xFE, 0xCA}, buf)
+	})
+
+	t.Run("it should round-trip with ReadOrderedT", func(t *testing.T) {
+		want := gofakeit.Float64()
+
+		buf, err := AppendOrderedT(nil, want, binary.BigEndian)
+		assert.NoError(t, err, "it should not return an error")
+
+		got, err := ReadOrderedT[float64](buf, 0, binary.BigEndian)
+		assert.NoError(t, err, "it should not return an error")
+		assert.Equal(t, want, got)
+	})
+}
+
+func doTestRoundTrip[T Numeric](t *testing.T, order binary.ByteOrder, gen func() T) {
+	for i := 0; i < 100; i++ {
+		want := gen()
+		buf := make([]byte, SizeOf[T]())
+
+		assert.NoError(t, WriteOrderedT(buf, 0, want, order))
+		got, err := ReadOrderedT[T](buf, 0, order)
+
+		assert.NoError(t, err, "it should not return an error")
+		assert.Equal(t, want, got)
+	}
+}
+
+func doTestRoundTrip_Order(t *testing.T, order binary.ByteOrder) {
+	name := order.String()
+
+	t.Run("it should round-trip "+name+" integers", func(t *testing.T) {
+		doTestRoundTrip(t, order, gofakeit.Int8)
+		doTestRoundTrip(t, order, gofakeit.Int16)
+		doTestRoundTrip(t, order, gofakeit.Int32)
+		doTestRoundTrip(t, order, gofakeit.Int64)
+		doTestRoundTrip(t, order, gofakeit.Uint8)
+		doTestRoundTrip(t, order, gofakeit.Uint16)
+		doTestRoundTrip(t, order, gofakeit.Uint32)
+		doTestRoundTrip(t, order, gofakeit.Uint64)
+		doTestRoundTrip(t, order, func() uintptr { return uintptr(gofakeit.Uint64()) })
+	})
+
+	t.Run("it should round-trip "+name+" floats", func(t *testing.T) {
+		doTestRoundTrip(t, order, gofakeit.Float32)
+		doTestRoundTrip(t, order, gofakeit.Float64)
+	})
+
+	t.Run("it should round-trip "+name+" NaN bit-for-bit", func(t *testing.T) {
+		want := math.Float64frombits(0x7FF8_0000_DEAD_BEEF)
+		buf := make([]byte, 8)
+
+		assert.NoError(t, WriteOrderedT(buf, 0, want, order))
+		got, err := ReadOrderedT[float64](buf, 0, order)
+
+		assert.NoError(t, err, "it should not return an error")
+		assert.True(t, math.IsNaN(got))
+		assert.Equal(t, math.Float64bits(want), math.Float64bits(got))
+	})
+}
+
+func TestRoundTrip_BigEndian(t *testing.T) {
+	doTestRoundTrip_Order(t, binary.BigEndian)
+}
+
+func TestRoundTrip_LittleEndian(t *testing.T) {
+	doTestRoundTrip_Order(t, binary.LittleEndian)
+}
+
+func TestRoundTrip_NativeEndian(t *testing.T) {
+	doTestRoundTrip_Order(t, binary.NativeEndian)
+}
+
+func doTestWriteSliceOrderedT_Order(t *testing.T, order binary.ByteOrder) {
+	name := order.String()
+
+	t.Run("it should write an empty "+name+" slice", func(t *testing.T) {
+		buf := []byte{0xDE, 0xAD}
+
+		n, err := WriteSliceOrderedT[uint32](buf, 0, nil, order)
+
+		assert.NoError(t, err, "it should not return an error")
+		assert.Zero(t, n)
+		assert.Equal(t, []byte{0xDE, 0xAD}, buf)
+	})
+
+	t.Run("it should write a single-element "+name+" slice", func(t *testing.T) {
+		want := []int64{gofakeit.Int64()}
+		buf := make([]byte, 8)
+
+		n, err := WriteSliceOrderedT(buf, 0, want, order)
+		assert.NoError(t, err, "it should not return an error")
+		assert.Equal(t, 8, n)
+
+		got, err := ReadSliceOrderedT[int64](buf, 0, 1, order)
+		assert.NoError(t, err, "it should not return an error")
+		assert.Equal(t, want, got)
+	})
+
+	t.Run("it should round-trip a multi-element "+name+" slice with ReadSliceOrderedT", func(t *testing.T) {
+		want := []float32{gofakeit.Float32(), gofakeit.Float32(), gofakeit.Float32()}
+		buf := make([]byte, 1+len(want)*4)
+
+		n, err := WriteSliceOrderedT(buf, 1, want, order)
+		assert.NoError(t, err, "it should not return an error")
+		assert.Equal(t, 12, n)
+
+		got, err := ReadSliceOrderedT[float32](buf, 1, len(want), order)
+		assert.NoError(t, err, "it should not return an error")
+		assert.Equal(t, want, got)
+	})
+}
+
+func TestWriteSliceOrderedT_BigEndian(t *testing.T) {
+	doTestWriteSliceOrderedT_Order(t, binary.BigEndian)
+}
+
+func TestWriteSliceOrderedT_LittleEndian(t *testing.T) {
+	doTestWriteSliceOrderedT_Order(t, binary.LittleEndian)
+}
+
+func TestWriteSliceOrderedT(t *testing.T) {
+	t.Run("it should return an EOF error and not modify the buffer when it is too short", func(t *testing.T) {
+		buf := make([]byte, 7)
+
+		n, err := WriteSliceOrderedT(buf, 0, []uint32{1, 2}, binary.LittleEndian)
+
+		assert.ErrorIs(t, err, io.EOF)
+		assert.Zero(t, n)
+		assert.Equal(t, make([]byte, 7), buf)
+	})
+}
+
+func TestAppendSliceOrderedT(t *testing.T) {
+	t.Run("it should append the encoding of each value", func(t *testing.T) {
+		buf, err := AppendSliceOrderedT([]byte{0xAA}, []uint16{0x0102, 0x0304}, binary.BigEndian)
+
+		assert.NoError(t, err, "it should not return an error")
+		assert.Equal(t, []byte{0xAA, 0x01, 0x02, 0x03, 0x04}, buf)
+	})
+
+	t.Run("it should round-trip with ReadSliceOrderedT", func(t *testing.T) {
+		want := make([]int32, 64)
+		for i := range want {
+			want[i] = gofakeit.Int32()
+		}
+
+		buf, err := AppendSliceOrderedT(nil, want, binary.LittleEndian)
+		assert.NoError(t, err, "it should not return an error")
+
+		got, err := ReadSliceOrderedT[int32](buf, 0, len(want), binary.LittleEndian)
+		assert.NoError(t, err, "it should not return an error")
+		assert.Equal(t, want, got)
+	})
+}
+
+func BenchmarkAppendSliceOrderedT(b *testing.B) {
+	values := make([]float64, 4096)
+	for i := range values {
+		values[i] = gofakeit.Float64()
+	}
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		_, _ = AppendSliceOrderedT(nil, values, binary.LittleEndian)
+	}
+}
@@ -0,0 +1,67 @@
+package buffergenerics
+
+import (
+	"encoding/binary"
+)
+
+// Writer appends consecutive values to a buffer using a default byte order.
+// It is the counterpart of Reader for building buffers, and can be reused with Reset.
+type Writer struct {
+	buffer []byte
+	order  binary.ByteOrder
+
+	// onAppend, if set, is called with the bytes appended by each write; it is installed by NewChecksumWriter.
+	onAppend func(data []byte)
+}
+
+// NewWriter returns a Writer appending to the given buffer, which may be nil, using the specified byte order
+// for all writes. If the byte order is nil, it defaults to binary.NativeEndian.
+func NewWriter(buffer []byte, order binary.ByteOrder) *Writer {
+	return &Writer{
+		buffer: buffer,
+		order:  order,
+	}
+}
+
+// Reset reinitializes the Writer to append to the given buffer using the specified byte order,
+// discarding all previous state. If the byte order is nil, it defaults to binary.NativeEndian.
+// A ChecksumWriter covering the Writer keeps covering it.
+func (w *Writer) Reset(buffer []byte, order binary.ByteOrder) {
+	*w = Writer{
+		buffer:   buffer,
+		order:    order,
+		onAppend: w.onAppend,
+	}
+}
+
+// Bytes returns the buffer built so far. It aliases the Writer's buffer until the next write.
+func (w *Writer) Bytes() []byte {
+	return w.buffer
+}
+
+// Len returns the length of the buffer built so far.
+func (w *Writer) Len() int {
+	return len(w.buffer)
+}
+
+// Order returns the byte order used by the Writer.
+func (w *Writer) Order() binary.ByteOrder {
+	return w.order
+}
+
+// WriteNext appends a value of type T to the buffer of the given Writer.
+// It returns any error encountered during the write operation, in which case the buffer is unchanged.
+// See also: AppendOrderedT.
+func WriteNext[T Numeric](w *Writer, value T) error {
+	buffer, err := AppendOrderedT[T](w.buffer, value, w.order)
+	if err != nil {
+		return err
+	}
+
+	if w.onAppend != nil {
+		w.onAppend(buffer[len(w.buffer):])
+	}
+
+	w.buffer = buffer
+	return nil
+}
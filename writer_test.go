@@ -0,0 +1,48 @@
+package buffergenerics
+
+import (
+	"encoding/binary"
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestWriter_WriteNext(t *testing.T) {
+	t.Run("it should append consecutive values readable by Reader", func(t *testing.T) {
+		order := binary.LittleEndian
+		want8, want64 := gofakeit.Uint8(), gofakeit.Float64()
+		w := NewWriter([]byte{0xAA}, order)
+
+		assert.NoError(t, WriteNext(w, want8))
+		assert.NoError(t, WriteNext(w, want64))
+
+		assert.Equal(t, 10, w.Len())
+		r := NewReader(w.Bytes()[1:], order)
+		got8, _ := ReadNext[uint8](r)
+		got64, _ := ReadNext[float64](r)
+		assert.Equal(t, want8, got8)
+		assert.Equal(t, want64, got64)
+	})
+
+	t.Run("it should assume binary.NativeEndian if no order is provided", func(t *testing.T) {
+		want := gofakeit.Uint32()
+		w := NewWriter(nil, nil)
+
+		assert.NoError(t, WriteNext(w, want))
+
+		assert.Equal(t, binary.NativeEndian.AppendUint32(nil, want), w.Bytes())
+	})
+}
+
+func TestWriter_Reset(t *testing.T) {
+	t.Run("it should discard previous state", func(t *testing.T) {
+		w := NewWriter(nil, binary.BigEndian)
+		_ = WriteNext[uint16](w, 0xDEAD)
+
+		w.Reset(nil, binary.LittleEndian)
+		_ = WriteNext[uint16](w, 0xDEAD)
+
+		assert.Equal(t, []byte{0xAD, 0xDE}, w.Bytes())
+		assert.Equal(t, binary.LittleEndian, w.Order())
+	})
+}